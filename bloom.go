@@ -0,0 +1,184 @@
+package orc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"time"
+)
+
+const DEFAULT_BLOOM_FILTER_FPP = 0.05
+
+// bloomFilter is the bloom filter of a row group, hashing values as the Java implementation does so that its readers
+// can test them
+type bloomFilter struct {
+	bits      []uint64
+	numHashes int32
+	// loc is the writer timezone, in which timestamps are hashed as milliseconds of wall clock time
+	loc *time.Location
+}
+
+func newBloomFilter(expected int, fpp float64, loc *time.Location) *bloomFilter {
+	n := float64(expected)
+	numBits := int(-n * math.Log(fpp) / (math.Ln2 * math.Ln2))
+	// rounded up to a whole number of words, always adding one as Java does
+	numBits += 64 - numBits%64
+	numHashes := int32(math.Round(float64(numBits) / n * math.Ln2))
+	if numHashes < 1 {
+		numHashes = 1
+	}
+	return &bloomFilter{bits: make([]uint64, numBits/64), numHashes: numHashes, loc: loc}
+}
+
+// checkBloomFilterKind reports whether columns of kind k can have bloom filters
+func checkBloomFilterKind(k Type_Kind) error {
+	switch k {
+	case Type_BYTE, Type_SHORT, Type_INT, Type_LONG, Type_FLOAT, Type_DOUBLE, Type_STRING, Type_VARCHAR, Type_CHAR,
+		Type_BINARY, Type_TIMESTAMP, Type_DATE, Type_DECIMAL:
+		return nil
+	}
+	return fmt.Errorf("bloom filters are not supported for %s columns", k)
+}
+
+// loadBloomFilter decodes a filter read from a BLOOM_FILTER or BLOOM_FILTER_UTF8 stream, hashing timestamps in loc
+func loadBloomFilter(m *BloomFilter, loc *time.Location) (*bloomFilter, error) {
+	words := m.GetBitset()
+	if bitset := m.GetUtf8Bitset(); len(bitset) > 0 {
+		if len(bitset)%8 != 0 {
+			return nil, fmt.Errorf("bloom filter of %d bytes, not whole words", len(bitset))
+		}
+		words = make([]uint64, len(bitset)/8)
+		for i := range words {
+			words[i] = binary.LittleEndian.Uint64(bitset[8*i:])
+		}
+	}
+	if len(words) == 0 || m.GetNumHashFunctions() == 0 || m.GetNumHashFunctions() > uint32(64*len(words)) {
+		return nil, fmt.Errorf("bloom filter of %d words and %d hash functions", len(words), m.GetNumHashFunctions())
+	}
+	return &bloomFilter{bits: words, numHashes: int32(m.GetNumHashFunctions()), loc: loc}, nil
+}
+
+// hash hashes a value in the representation its column writer encodes, ok reporting whether it has one
+func (b *bloomFilter) hash(v interface{}) (hash uint64, ok bool) {
+	switch v := v.(type) {
+	case int64:
+		return longHash(v), true
+	case float64:
+		return longHash(int64(math.Float64bits(v))), true
+	case string:
+		return murmur3([]byte(v)), true
+	case []byte:
+		return murmur3(v), true
+	case time.Time:
+		return longHash(localMillis(v, b.loc)), true
+	case Decimal:
+		return murmur3([]byte(decimalString(v))), true
+	}
+	return 0, false
+}
+
+// add adds a value in the representation its column writer encodes
+func (b *bloomFilter) add(v interface{}) {
+	if hash, ok := b.hash(v); ok {
+		b.addHash(hash)
+	}
+}
+
+func (b *bloomFilter) addHash(hash uint64) {
+	numBits := int32(len(b.bits) * 64)
+	for i := int32(1); i <= b.numHashes; i++ {
+		pos := bloomPosition(hash, i, numBits)
+		b.bits[pos>>6] |= 1 << uint(pos&63)
+	}
+}
+
+// test reports whether the filter might contain a value, as it always might when the value has no hash
+func (b *bloomFilter) test(v interface{}) bool {
+	hash, ok := b.hash(v)
+	if !ok {
+		return true
+	}
+	numBits := int32(len(b.bits) * 64)
+	for i := int32(1); i <= b.numHashes; i++ {
+		pos := bloomPosition(hash, i, numBits)
+		if b.bits[pos>>6]&(1<<uint(pos&63)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomPosition returns the bit set by the i-th hash function
+func bloomPosition(hash uint64, i int32, numBits int32) int32 {
+	combined := int32(hash) + i*int32(hash>>32)
+	if combined < 0 {
+		combined = ^combined
+	}
+	return combined % numBits
+}
+
+// message returns the filter serialized for a BLOOM_FILTER_UTF8 stream
+func (b *bloomFilter) message() *BloomFilter {
+	bitset := make([]byte, 8*len(b.bits))
+	for i, word := range b.bits {
+		binary.LittleEndian.PutUint64(bitset[8*i:], word)
+	}
+	numHashes := uint32(b.numHashes)
+	return &BloomFilter{NumHashFunctions: &numHashes, Utf8Bitset: bitset}
+}
+
+func (b *bloomFilter) reset() {
+	for i := range b.bits {
+		b.bits[i] = 0
+	}
+}
+
+// longHash is Thomas Wang's 64 bit integer hash, which the Java implementation applies to integers
+func longHash(key int64) uint64 {
+	key = ^key + key<<21
+	key ^= key >> 24
+	key = key + key<<3 + key<<8
+	key ^= key >> 14
+	key = key + key<<2 + key<<4
+	key ^= key >> 28
+	key += key << 31
+	return uint64(key)
+}
+
+const (
+	murmurC1   = 0x87c37b91114253d5
+	murmurC2   = 0x4cf5ad432745937f
+	murmurSeed = 104729
+)
+
+// murmur3 is the 64 bit variant of Murmur3 the Java implementation applies to bytes
+func murmur3(data []byte) uint64 {
+	hash := uint64(murmurSeed)
+	n := len(data) / 8 * 8
+	for i := 0; i < n; i += 8 {
+		k := binary.LittleEndian.Uint64(data[i:])
+		k *= murmurC1
+		k = bits.RotateLeft64(k, 31)
+		k *= murmurC2
+		hash ^= k
+		hash = bits.RotateLeft64(hash, 27)*5 + 0x52dce729
+	}
+	if tail := data[n:]; len(tail) > 0 {
+		var k uint64
+		for i := len(tail) - 1; i >= 0; i-- {
+			k = k<<8 | uint64(tail[i])
+		}
+		k *= murmurC1
+		k = bits.RotateLeft64(k, 31)
+		k *= murmurC2
+		hash ^= k
+	}
+	hash ^= uint64(len(data))
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+	return hash
+}
@@ -0,0 +1,150 @@
+package orc
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// openBytes opens a file written to memory
+func openBytes(t *testing.T, data []byte) *File {
+	path := filepath.Join(t.TempDir(), "test.orc")
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(f.Close)
+	return f
+}
+
+// readIndexStreams returns the decompressed index streams of a column in a stripe
+func readIndexStreams(t *testing.T, f *File, stripe int, column uint32) map[Stream_Kind][]byte {
+	info := f.Footer.GetStripes()[stripe]
+	footer, err := f.GetStripeFooter(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, info.GetIndexLength())
+	if _, err := f.r.ReadAt(buf, int64(info.GetOffset())); err != nil {
+		t.Fatal(err)
+	}
+	streams := make(map[Stream_Kind][]byte)
+	var offset uint64
+	for _, s := range footer.GetStreams() {
+		if offset >= info.GetIndexLength() {
+			break
+		}
+		data := buf[offset : offset+s.GetLength()]
+		offset += s.GetLength()
+		if s.GetColumn() != column {
+			continue
+		}
+		if f.PostScript.GetCompression() != CompressionKind_NONE {
+			if data, err = ioutil.ReadAll(f.compressedReader(bytes.NewReader(data))); err != nil {
+				t.Fatal(err)
+			}
+		}
+		streams[s.GetKind()] = data
+	}
+	return streams
+}
+
+// readBloomFilters decodes the BLOOM_FILTER_UTF8 stream of a column in a stripe
+func readBloomFilters(t *testing.T, f *File, stripe int, column uint32) []*bloomFilter {
+	var index BloomFilterIndex
+	if err := proto.Unmarshal(readIndexStreams(t, f, stripe, column)[Stream_BLOOM_FILTER_UTF8], &index); err != nil {
+		t.Fatal(err)
+	}
+	var filters []*bloomFilter
+	for _, m := range index.GetBloomFilter() {
+		b, err := loadBloomFilter(m, time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		filters = append(filters, b)
+	}
+	return filters
+}
+
+func TestBloomFilterSize(t *testing.T) {
+	// as sized by Java writers, such as the filters of examples/over1k_bloom.orc
+	b := newBloomFilter(10000, 0.05, time.UTC)
+	if len(b.bits) != 975 || b.numHashes != 4 {
+		t.Errorf("got %d words and %d hash functions; want 975 and 4", len(b.bits), b.numHashes)
+	}
+}
+
+func TestBloomFilterFalsePositives(t *testing.T) {
+	b := newBloomFilter(10000, 0.05, time.UTC)
+	for i := int64(0); i < 10000; i++ {
+		b.add(i)
+	}
+	var positives int
+	for i := int64(10000); i < 20000; i++ {
+		if !b.test(i - 10000) {
+			t.Fatalf("%d not in bloom filter", i-10000)
+		}
+		if b.test(i) {
+			positives++
+		}
+	}
+	if positives > 800 {
+		t.Errorf("got %d false positives of 10000; want about 500", positives)
+	}
+}
+
+func TestWriterBloomFilters(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2}, FieldNames: []string{"s", "d"}},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_DECIMAL.Enum(), Precision: proto.Uint32(10), Scale: proto.Uint32(2)},
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, types, &WriterOptions{RowIndexStride: 100, BloomFilterColumns: []uint32{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := func(i int) []interface{} {
+		return []interface{}{fmt.Sprintf("value %d", i), Decimal{Value: big.NewInt(int64(i * 10)), Scale: 2}}
+	}
+	for i := 0; i < 1000; i++ {
+		if err := w.WriteRow(row(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f := openBytes(t, buf.Bytes())
+
+	for _, column := range []uint32{1, 2} {
+		filters := readBloomFilters(t, f, 0, column)
+		if len(filters) != 10 {
+			t.Fatalf("got %d bloom filters; want 10", len(filters))
+		}
+		for g, b := range filters {
+			for i := 100 * g; i < 100*(g+1); i++ {
+				v := row(i)[column-1]
+				if !b.test(v) {
+					t.Errorf("column %d row group %d: %v not in bloom filter", column, g, v)
+				}
+			}
+		}
+	}
+
+	if _, err := NewWriter(&bytes.Buffer{}, types, &WriterOptions{RowIndexStride: -1, BloomFilterColumns: []uint32{1}}); err == nil {
+		t.Error("expected error for bloom filters without row indexes")
+	}
+	if _, err := NewWriter(&bytes.Buffer{}, types, &WriterOptions{BloomFilterColumns: []uint32{0}}); err == nil {
+		t.Error("expected error for bloom filters of a struct")
+	}
+}
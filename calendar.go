@@ -0,0 +1,106 @@
+package orc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// gregorianCutover is 1582-10-15 in days since 1970-01-01, the first day of the Gregorian calendar. Earlier days
+	// of the hybrid calendar are in the Julian calendar.
+	gregorianCutover = -141427
+	// epochJulianDay is the Julian day number of 1970-01-01
+	epochJulianDay = 2440588
+	secondsPerDay  = 24 * 60 * 60
+)
+
+// julianDays returns the days since 1970-01-01 of a date of the Julian calendar
+func julianDays(year int, month time.Month, day int) int64 {
+	a := (14 - int64(month)) / 12
+	y := int64(year) + 4800 - a
+	m := int64(month) + 12*a - 3
+	return int64(day) + (153*m+2)/5 + 365*y + y/4 - 32083 - epochJulianDay
+}
+
+// julianDate returns the date of the Julian calendar days after 1970-01-01
+func julianDate(days int64) (int, time.Month, int) {
+	c := days + epochJulianDay + 32082
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day := e - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := d - 4800 + m/10
+	return int(year), time.Month(month), int(day)
+}
+
+// hybridToProleptic returns the day of the proleptic Gregorian calendar with the year, month and day of a day of
+// the hybrid calendar
+func hybridToProleptic(days int64) int64 {
+	if days >= gregorianCutover {
+		return days
+	}
+	y, m, d := julianDate(days)
+	return daysSinceEpoch(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// prolepticToHybrid returns the day of the hybrid calendar with the year, month and day of a day of the proleptic
+// Gregorian calendar
+func prolepticToHybrid(days int64) int64 {
+	if days >= gregorianCutover {
+		return days
+	}
+	y, m, d := time.Unix(days*secondsPerDay, 0).UTC().Date()
+	return julianDays(y, m, d)
+}
+
+// rebaseTime returns the time on the day with the year, month and day of t in the other calendar, from the hybrid
+// calendar to the proleptic Gregorian calendar or back, keeping its clock time and location
+func rebaseTime(t time.Time, toProleptic bool) time.Time {
+	days := daysSinceEpoch(t)
+	if days >= gregorianCutover {
+		return t
+	}
+	if toProleptic {
+		days = hybridToProleptic(days)
+	} else {
+		days = prolepticToHybrid(days)
+	}
+	y, m, d := time.Unix(days*secondsPerDay, 0).UTC().Date()
+	hour, min, sec := t.Clock()
+	return time.Date(y, m, d, hour, min, sec, t.Nanosecond(), t.Location())
+}
+
+// rebaseMillis rebases a timestamp of the statistics given in milliseconds since 1970-01-01 UTC
+func rebaseMillis(millis int64, toProleptic bool) int64 {
+	t := rebaseTime(timeOfMillis(millis), toProleptic)
+	// nanoseconds since the epoch overflow before 1678
+	return t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+}
+
+// rebaseStatistics returns the statistics of a date or timestamp column rebased to the proleptic Gregorian calendar
+// when the file is read rebased
+func (f *File) rebaseStatistics(s *ColumnStatistics) *ColumnStatistics {
+	if !f.rebase || (s.GetDateStatistics() == nil && s.GetTimestampStatistics() == nil) {
+		return s
+	}
+	s = proto.Clone(s).(*ColumnStatistics)
+	if dates := s.DateStatistics; dates != nil {
+		if dates.Minimum != nil {
+			dates.Minimum = proto.Int32(int32(hybridToProleptic(int64(dates.GetMinimum()))))
+		}
+		if dates.Maximum != nil {
+			dates.Maximum = proto.Int32(int32(hybridToProleptic(int64(dates.GetMaximum()))))
+		}
+	}
+	if timestamps := s.TimestampStatistics; timestamps != nil {
+		for _, millis := range []*int64{timestamps.Minimum, timestamps.Maximum, timestamps.MinimumUtc,
+			timestamps.MaximumUtc} {
+			if millis != nil {
+				*millis = rebaseMillis(*millis, true)
+			}
+		}
+	}
+	return s
+}
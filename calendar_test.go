@@ -0,0 +1,162 @@
+package orc
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRebaseDays(t *testing.T) {
+	for _, tc := range []struct {
+		julian, proleptic time.Time
+	}{
+		{date(1582, time.October, 4), date(1582, time.October, 14)},
+		{date(1000, time.January, 1), date(1000, time.January, 6)},
+		{date(1, time.January, 1), date(0, time.December, 30)},
+		{date(200, time.March, 1), date(200, time.March, 1)},
+	} {
+		hybrid := julianDays(tc.julian.Date())
+		if y, m, d := julianDate(hybrid); !date(y, m, d).Equal(tc.julian) {
+			t.Errorf("julian date of day %d: got %d-%d-%d; want %s", hybrid, y, m, d, tc.julian)
+		}
+		if got := daysSinceEpoch(tc.proleptic); got != hybrid {
+			t.Errorf("%s: got day %d; want %d", tc.julian, got, hybrid)
+		}
+		// the same day has other dates in the two calendars, rebasing keeps the date
+		if got, want := hybridToProleptic(hybrid), daysSinceEpoch(tc.julian); got != want {
+			t.Errorf("%s: got rebased day %d; want %d", tc.julian, got, want)
+		}
+		if got := prolepticToHybrid(daysSinceEpoch(tc.julian)); got != hybrid {
+			t.Errorf("%s: got hybrid day %d; want %d", tc.julian, got, hybrid)
+		}
+	}
+
+	for _, days := range []int64{gregorianCutover, gregorianCutover + 1, 0, 20000} {
+		if hybridToProleptic(days) != days || prolepticToHybrid(days) != days {
+			t.Errorf("day %d rebased", days)
+		}
+	}
+	for days := int64(-700000); days < gregorianCutover; days += 97 {
+		// the leap days of the Julian calendar missing from the Gregorian calendar do not round trip
+		if _, m, d := julianDate(days); m == time.February && d == 29 {
+			continue
+		}
+		if got := prolepticToHybrid(hybridToProleptic(days)); got != days {
+			t.Errorf("day %d rebased back to %d", days, got)
+		}
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2}, FieldNames: []string{"date", "timestamp"}},
+		{Kind: Type_DATE.Enum()},
+		{Kind: Type_TIMESTAMP.Enum()},
+	}
+	dates := []time.Time{date(1000, time.January, 1), date(1582, time.October, 4), date(1582, time.October, 15),
+		date(2000, time.February, 29)}
+	write := func(hybrid bool) string {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, types, &WriterOptions{HybridCalendar: hybrid, BloomFilterColumns: []uint32{1}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range dates {
+			if err := w.WriteRow([]interface{}{d, d.Add(13*time.Hour + 5*time.Millisecond)}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return openBytes(t, buf.Bytes()).r.Name()
+	}
+
+	for _, tc := range []struct {
+		name           string
+		hybrid, rebase bool
+		calendar       CalendarKind
+		// shift is the days by which dates before the cutover read later than written
+		shift []int
+	}{
+		{"proleptic", false, false, CalendarKind_PROLEPTIC_GREGORIAN, []int{0, 0, 0, 0}},
+		{"proleptic rebased", false, true, CalendarKind_PROLEPTIC_GREGORIAN, []int{0, 0, 0, 0}},
+		{"hybrid", true, false, CalendarKind_JULIAN_GREGORIAN, []int{5, 10, 0, 0}},
+		{"hybrid rebased", true, true, CalendarKind_JULIAN_GREGORIAN, []int{0, 0, 0, 0}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := OpenWithOptions(write(tc.hybrid), &OpenOptions{RebaseCalendar: tc.rebase})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			if f.Calendar() != tc.calendar {
+				t.Errorf("got calendar %s; want %s", f.Calendar(), tc.calendar)
+			}
+			var got []time.Time
+			for i, row := range readAll(t, f) {
+				want := dates[i].AddDate(0, 0, tc.shift[i])
+				if !row[0].(time.Time).Equal(want) || !row[1].(time.Time).Equal(want.Add(13*time.Hour+5*time.Millisecond)) {
+					t.Errorf("row %d: got %v; want %s", i, row, want)
+				}
+				got = append(got, row[0].(time.Time))
+			}
+
+			stats := f.Statistics()
+			min := time.Unix(int64(stats[1].GetDateStatistics().GetMinimum())*secondsPerDay, 0).UTC()
+			if !min.Equal(got[0]) {
+				t.Errorf("got minimum date %s; want %s", min, got[0])
+			}
+			millis := stats[2].GetTimestampStatistics().GetMinimumUtc()
+			minLocal := time.Unix(millis/1000, millis%1000*int64(time.Millisecond)).UTC()
+			if want := got[0].Add(13*time.Hour + 5*time.Millisecond); !minLocal.Equal(want) {
+				t.Errorf("got minimum timestamp %s; want %s", minLocal, want)
+			}
+
+			groups, err := f.RowGroups(0, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range got {
+				if !groups[0].MightContain(d) {
+					t.Errorf("bloom filter rules out %s", d)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarJava(t *testing.T) {
+	path := filepath.Join("examples", "TestOrcFile.testDate1900.orc")
+	f, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rebased, err := OpenWithOptions(path, &OpenOptions{RebaseCalendar: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rebased.Close()
+	if f.Calendar() != CalendarKind_JULIAN_GREGORIAN {
+		t.Errorf("got calendar %s", f.Calendar())
+	}
+	// the dates from 1900 are the same in both calendars
+	batch, err := f.ReadStripe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := rebased.ReadStripe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(batch, want) {
+		t.Error("rebasing changed dates after 1582")
+	}
+}
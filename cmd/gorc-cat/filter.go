@@ -0,0 +1,287 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphaelli/gorc"
+	"github.com/graphaelli/gorc/internal/jsonl"
+)
+
+// filter reports whether a row is to be printed
+type filter func(row []interface{}) bool
+
+// condition compares the value of a column to a literal, or checks it for null
+type condition struct {
+	path  string
+	op    string
+	value string
+}
+
+// parseFilter parses conditions joined by "and" and "or", the former binding tighter, such as
+// `age >= 18 and name != 'x' or email is null`. A condition compares a column, named by its path of field names,
+// with =, !=, <, <=, > or >= to a literal, which is quoted when it holds spaces, or is "is null" or "is not null".
+func parseFilter(expr string) ([][]condition, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	var alternatives [][]condition
+	var all []condition
+	for len(tokens) > 0 {
+		if len(tokens) < 3 {
+			return nil, fmt.Errorf("incomplete condition %q", strings.Join(tokens, " "))
+		}
+		c := condition{path: tokens[0], op: tokens[1], value: tokens[2]}
+		tokens = tokens[3:]
+		switch strings.ToLower(c.op) {
+		case "=", "==", "!=", "<", "<=", ">", ">=":
+		case "is":
+			switch {
+			case strings.EqualFold(c.value, "null"):
+				c.op = "is null"
+			case strings.EqualFold(c.value, "not") && len(tokens) > 0 && strings.EqualFold(tokens[0], "null"):
+				c.op = "is not null"
+				tokens = tokens[1:]
+			default:
+				return nil, fmt.Errorf("expected null or not null after %q is", c.path)
+			}
+		default:
+			return nil, fmt.Errorf("unknown operator %q", c.op)
+		}
+		all = append(all, c)
+		if len(tokens) == 0 {
+			break
+		}
+		switch strings.ToLower(tokens[0]) {
+		case "and":
+		case "or":
+			alternatives = append(alternatives, all)
+			all = nil
+		default:
+			return nil, fmt.Errorf("expected and or or, got %q", tokens[0])
+		}
+		tokens = tokens[1:]
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("filter %q ends in a conjunction", expr)
+		}
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("empty filter")
+	}
+	return append(alternatives, all), nil
+}
+
+// tokenize splits expr on spaces and around operators, keeping quoted literals whole
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(expr[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated literal %s", expr[i:])
+			}
+			tokens = append(tokens, expr[i+1:i+1+end])
+			i += end + 2
+		case strings.IndexByte("=!<>", c) >= 0:
+			j := i + 1
+			if j < len(expr) && expr[j] == '=' {
+				j++
+			}
+			tokens = append(tokens, expr[i:j])
+			i = j
+		default:
+			j := i
+			for j < len(expr) && strings.IndexByte(" \t'\"=!<>", expr[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, expr[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// compileFilter compiles a filter of the rows of f
+func compileFilter(f *orc.File, expr string) (filter, error) {
+	conditions, err := parseFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	alternatives := make([][]filter, len(conditions))
+	for i, all := range conditions {
+		for _, c := range all {
+			match, err := compileCondition(f, c)
+			if err != nil {
+				return nil, err
+			}
+			alternatives[i] = append(alternatives[i], match)
+		}
+	}
+	return func(row []interface{}) bool {
+		for _, all := range alternatives {
+			matched := true
+			for _, match := range all {
+				if matched = match(row); !matched {
+					break
+				}
+			}
+			if matched {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func compileCondition(f *orc.File, c condition) (filter, error) {
+	fields, id, err := fieldPath(f, c.path)
+	if err != nil {
+		return nil, err
+	}
+	switch c.op {
+	case "is null":
+		return func(row []interface{}) bool { return valueAt(row, fields) == nil }, nil
+	case "is not null":
+		return func(row []interface{}) bool { return valueAt(row, fields) != nil }, nil
+	}
+
+	compare, err := comparison(f.Footer.GetTypes()[id].GetKind(), c.value)
+	if err != nil {
+		return nil, fmt.Errorf("comparing %s: %w", c.path, err)
+	}
+	var accept func(int) bool
+	switch c.op {
+	case "=", "==":
+		accept = func(n int) bool { return n == 0 }
+	case "!=":
+		accept = func(n int) bool { return n != 0 }
+	case "<":
+		accept = func(n int) bool { return n < 0 }
+	case "<=":
+		accept = func(n int) bool { return n <= 0 }
+	case ">":
+		accept = func(n int) bool { return n > 0 }
+	case ">=":
+		accept = func(n int) bool { return n >= 0 }
+	}
+	// as in SQL, nulls and values that do not compare, such as NaN, match no comparison
+	return func(row []interface{}) bool {
+		v := valueAt(row, fields)
+		if v == nil {
+			return false
+		}
+		n, ok := compare(v)
+		return ok && accept(n)
+	}, nil
+}
+
+// comparison parses literal as a value of a column of the given kind, returning a function comparing the values of
+// the column to it
+func comparison(kind orc.Type_Kind, literal string) (func(v interface{}) (int, bool), error) {
+	switch kind {
+	case orc.Type_BOOLEAN:
+		b, err := strconv.ParseBool(literal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q", literal)
+		}
+		return func(v interface{}) (int, bool) {
+			// false sorts before true
+			return boolInt(v.(bool)) - boolInt(b), true
+		}, nil
+	case orc.Type_BYTE, orc.Type_SHORT, orc.Type_INT, orc.Type_LONG, orc.Type_FLOAT, orc.Type_DOUBLE,
+		orc.Type_DECIMAL:
+		r, ok := new(big.Rat).SetString(literal)
+		if !ok {
+			return nil, fmt.Errorf("invalid number %q", literal)
+		}
+		return func(v interface{}) (int, bool) {
+			x, ok := rat(v)
+			if !ok {
+				return 0, false
+			}
+			return x.Cmp(r), true
+		}, nil
+	case orc.Type_STRING, orc.Type_CHAR, orc.Type_VARCHAR:
+		return func(v interface{}) (int, bool) { return strings.Compare(v.(string), literal), true }, nil
+	case orc.Type_BINARY:
+		return func(v interface{}) (int, bool) { return bytes.Compare(v.([]byte), []byte(literal)), true }, nil
+	case orc.Type_DATE, orc.Type_TIMESTAMP:
+		t, err := parseTime(literal)
+		if err != nil {
+			return nil, err
+		}
+		return func(v interface{}) (int, bool) {
+			// values compare by the wall clock time they are printed with
+			switch w := wallClock(v.(time.Time)); {
+			case w.Before(t):
+				return -1, true
+			case w.After(t):
+				return 1, true
+			}
+			return 0, true
+		}, nil
+	}
+	return nil, fmt.Errorf("columns of kind %s only compare to null", kind)
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// rat converts a number to a rational, failing for NaN and infinities
+func rat(v interface{}) (*big.Rat, bool) {
+	switch v := v.(type) {
+	case int8:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int16:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int32:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int64:
+		return new(big.Rat).SetInt64(v), true
+	case float32:
+		return floatRat(float64(v))
+	case float64:
+		return floatRat(v)
+	case orc.Decimal:
+		return new(big.Rat).SetString(v.String())
+	}
+	return nil, false
+}
+
+func floatRat(f float64) (*big.Rat, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, false
+	}
+	return new(big.Rat).SetFloat64(f), true
+}
+
+// timeLayouts are the layouts of time literals
+var timeLayouts = []string{jsonl.TimeLayout, "2006-01-02T15:04:05.999999999", "2006-01-02"}
+
+// parseTime parses a date or timestamp literal as a wall clock time, held in UTC
+func parseTime(literal string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, literal); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, expected a date or 2006-01-02 15:04:05", literal)
+}
+
+// wallClock returns the wall clock time of t as if it were UTC
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/graphaelli/gorc"
+)
+
+func TestParseFilter(t *testing.T) {
+	got, err := parseFilter(`a>=1 and b.c != 'x y' or d is not null AND e IS NULL or f=="z"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]condition{
+		{{"a", ">=", "1"}, {"b.c", "!=", "x y"}},
+		{{"d", "is not null", "not"}, {"e", "is null", "NULL"}},
+		{{"f", "==", "z"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+
+	for _, expr := range []string{"", "a", "a =", "a ~ 1", "a = 'b", "a = 1 and", "a = 1 b = 2", "a is not"} {
+		if _, err := parseFilter(expr); err == nil {
+			t.Errorf("%q: got no error", expr)
+		}
+	}
+}
+
+func TestComparison(t *testing.T) {
+	at := time.Date(2000, time.March, 12, 15, 0, 0, 0, time.FixedZone("PST", -8*3600))
+	decimal, _ := orc.ParseDecimal("12.50")
+	for _, tc := range []struct {
+		kind    orc.Type_Kind
+		literal string
+		value   interface{}
+		want    int
+	}{
+		{orc.Type_BOOLEAN, "true", false, -1},
+		{orc.Type_INT, "7", int32(7), 0},
+		{orc.Type_LONG, "1.5", int64(2), 1},
+		{orc.Type_DOUBLE, "1e3", 999.5, -1},
+		{orc.Type_DECIMAL, "12.5", decimal, 0},
+		{orc.Type_STRING, "b", "a", -1},
+		{orc.Type_BINARY, "a", []byte("b"), 1},
+		{orc.Type_DATE, "2000-03-12", time.Date(2000, time.March, 12, 0, 0, 0, 0, time.UTC), 0},
+		// timestamps compare by their wall clock time
+		{orc.Type_TIMESTAMP, "2000-03-12 15:00:00", at, 0},
+		{orc.Type_TIMESTAMP, "2000-03-12T15:00:00.5", at, -1},
+	} {
+		compare, err := comparison(tc.kind, tc.literal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, ok := compare(tc.value); !ok || got != tc.want {
+			t.Errorf("%s %v to %s: got %d", tc.kind, tc.value, tc.literal, got)
+		}
+	}
+
+	compare, err := comparison(orc.Type_FLOAT, "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := compare(float32(math.NaN())); ok {
+		t.Error("compared NaN")
+	}
+	for _, kind := range []orc.Type_Kind{orc.Type_INT, orc.Type_BOOLEAN, orc.Type_DATE, orc.Type_STRUCT} {
+		if _, err := comparison(kind, "x"); err == nil {
+			t.Errorf("%s: got no error comparing to x", kind)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+// gorc-cat prints the rows of ORC files as JSON Lines, one object per row, as the Java tools' `orc-tools data` does.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/graphaelli/gorc"
+	"github.com/graphaelli/gorc/internal/jsonl"
+)
+
+// masks collects the -mask flags
+type masks []orc.ColumnMask
+
+func (m *masks) String() string {
+	var s []string
+	for _, mask := range *m {
+		s = append(s, mask.Path+"="+mask.Kind.String())
+	}
+	return strings.Join(s, ",")
+}
+
+func (m *masks) Set(s string) error {
+	mask, err := orc.ParseColumnMask(s)
+	if err != nil {
+		return err
+	}
+	*m = append(*m, mask)
+	return nil
+}
+
+var (
+	columnMasks masks
+	columns     = flag.String("columns", "", "comma separated paths of the columns to print, such as name,address.city; all by default")
+	where       = flag.String("where", "", "print only the rows matching conditions such as \"age >= 18 and email is not null\"")
+	offset      = flag.Int("offset", 0, "number of rows to skip, after filtering, before printing any")
+	limit       = flag.Int("limit", -1, "maximum number of rows to print, -1 for all")
+	stripes     = flag.String("stripes", "", "comma separated stripes, or ranges of them such as 2-4, to read; all by default")
+)
+
+func init() {
+	flag.Var(&columnMasks, "mask", "mask the values of a column as path=nullify|redact|sha256|keep-last-N, repeatable")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] <filename> [<filename> ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+// options selects the rows and columns to print. Offset and limit count the rows of all files together.
+type options struct {
+	// columns are the paths of the columns to print, nil for all
+	columns []string
+	// stripes are the stripes to read, nil for all
+	stripes map[int]bool
+	where   string
+	offset  int
+	// limit is the maximum number of rows to print, negative for all
+	limit int
+	masks []orc.ColumnMask
+}
+
+// parseStripes parses stripes and ranges of them such as "0,2-4"
+func parseStripes(s string) (map[int]bool, error) {
+	if s == "" {
+		return nil, nil
+	}
+	selected := make(map[int]bool)
+	for _, part := range strings.Split(s, ",") {
+		first, last := part, part
+		if i := strings.IndexByte(part, '-'); i > 0 {
+			first, last = part[:i], part[i+1:]
+		}
+		from, err := strconv.Atoi(strings.TrimSpace(first))
+		if err != nil || from < 0 {
+			return nil, fmt.Errorf("invalid stripe %q", part)
+		}
+		to, err := strconv.Atoi(strings.TrimSpace(last))
+		if err != nil || to < from {
+			return nil, fmt.Errorf("invalid stripe range %q", part)
+		}
+		for i := from; i <= to; i++ {
+			selected[i] = true
+		}
+	}
+	return selected, nil
+}
+
+// printer prints rows, keeping count of them across files
+type printer struct {
+	out     *bufio.Writer
+	opts    options
+	skipped int
+	printed int
+}
+
+// done reports whether the limit has been reached
+func (p *printer) done() bool {
+	return p.opts.limit >= 0 && p.printed >= p.opts.limit
+}
+
+// cat prints the rows of a file
+func (p *printer) cat(filename string) error {
+	f, err := orc.OpenWithOptions(filename, &orc.OpenOptions{Masks: p.opts.masks})
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	types := f.Footer.GetTypes()
+	if len(types) == 0 {
+		return nil
+	}
+
+	var write func(row []interface{}) error
+	if p.opts.columns == nil {
+		write = func(row []interface{}) error {
+			return jsonl.WriteRow(p.out, types, row)
+		}
+	} else {
+		paths := make([][]int, len(p.opts.columns))
+		ids := make([]uint32, len(p.opts.columns))
+		for c, column := range p.opts.columns {
+			if paths[c], ids[c], err = fieldPath(f, column); err != nil {
+				return err
+			}
+		}
+		write = func(row []interface{}) error {
+			p.out.WriteByte('{')
+			for c, column := range p.opts.columns {
+				if c > 0 {
+					p.out.WriteByte(',')
+				}
+				if err := jsonl.WriteJSON(p.out, column); err != nil {
+					return err
+				}
+				p.out.WriteByte(':')
+				if err := jsonl.WriteValue(p.out, types, ids[c], valueAt(row, paths[c])); err != nil {
+					return err
+				}
+			}
+			p.out.WriteByte('}')
+			return p.out.WriteByte('\n')
+		}
+	}
+
+	var match filter
+	if p.opts.where != "" {
+		if match, err = compileFilter(f, p.opts.where); err != nil {
+			return err
+		}
+	}
+
+	for i, info := range f.Footer.GetStripes() {
+		if p.done() {
+			break
+		}
+		if p.opts.stripes != nil && !p.opts.stripes[i] {
+			continue
+		}
+		// without a filter, stripes wholly within the offset need not be decoded
+		if rows := int(info.GetNumberOfRows()); match == nil && p.skipped+rows <= p.opts.offset {
+			p.skipped += rows
+			continue
+		}
+		batch, err := f.ReadStripe(i)
+		if err != nil {
+			return err
+		}
+		for r := 0; r < batch.NumRows && !p.done(); r++ {
+			row := batch.Row(r)
+			if match != nil && !match(row) {
+				continue
+			}
+			if p.skipped < p.opts.offset {
+				p.skipped++
+				continue
+			}
+			if err := write(row); err != nil {
+				return err
+			}
+			p.printed++
+		}
+	}
+	return nil
+}
+
+// fieldPath resolves the path of a column nested in structs only to the index of its field in each, returning the
+// id of the column as well
+func fieldPath(f *orc.File, path string) ([]int, uint32, error) {
+	if _, err := f.FindColumn(path); err != nil {
+		return nil, 0, err
+	}
+	types := f.Footer.GetTypes()
+	var fields []int
+	var id uint32
+	for _, name := range strings.Split(path, ".") {
+		t := types[id]
+		if t.GetKind() != orc.Type_STRUCT {
+			return nil, 0, fmt.Errorf("column %q is not a field of structs", path)
+		}
+		for i, field := range t.GetFieldNames() {
+			if field == name {
+				fields = append(fields, i)
+				id = t.GetSubtypes()[i]
+				break
+			}
+		}
+	}
+	return fields, id, nil
+}
+
+// valueAt returns the value of the field at the indexes of fields within row, null when any struct holding it is
+func valueAt(row []interface{}, fields []int) interface{} {
+	var v interface{} = row
+	for _, i := range fields {
+		s, ok := v.([]interface{})
+		if !ok {
+			return nil
+		}
+		v = s[i]
+	}
+	return v
+}
+
+func main() {
+	flag.Parse()
+	if len(flag.Args()) < 1 {
+		flag.Usage()
+		return
+	}
+
+	opts := options{where: *where, offset: *offset, limit: *limit, masks: columnMasks}
+	if *columns != "" {
+		opts.columns = strings.Split(*columns, ",")
+	}
+	var err error
+	if opts.stripes, err = parseStripes(*stripes); err != nil {
+		log.Fatalln(err)
+	}
+	if opts.where != "" {
+		// report a malformed filter before printing any row
+		if _, err := parseFilter(opts.where); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	p := &printer{out: bufio.NewWriter(os.Stdout), opts: opts}
+	for _, filename := range flag.Args() {
+		if p.done() {
+			break
+		}
+		if err := p.cat(filename); err != nil {
+			p.out.Flush()
+			log.Fatalf("%s: %v", filename, err)
+		}
+	}
+	if err := p.out.Flush(); err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func example(name string) string {
+	return filepath.Join("..", "..", "examples", name)
+}
+
+func TestCat(t *testing.T) {
+	test1 := `{"boolean1":false,"byte1":1,"short1":1024,"int1":65536,"long1":9223372036854775807,"float1":1,` +
+		`"double1":-15,"bytes1":[0,1,2,3,4],"string1":"hi","middle":{"list":[{"int1":1,"string1":"bye"},` +
+		`{"int1":2,"string1":"sigh"}]},"list":[{"int1":3,"string1":"good"},{"int1":4,"string1":"bad"}],"map":[]}` + "\n"
+	for _, tc := range []struct {
+		name  string
+		files []string
+		opts  options
+		want  string
+	}{
+		{
+			name:  "all",
+			files: []string{example("TestOrcFile.test1.orc")},
+			opts:  options{limit: 1},
+			want:  test1,
+		},
+		{
+			name:  "projection",
+			files: []string{example("TestOrcFile.test1.orc")},
+			opts:  options{columns: []string{"string1", "middle.list", "map"}, offset: 1, limit: -1},
+			want: `{"string1":"bye","middle.list":[{"int1":1,"string1":"bye"},{"int1":2,"string1":"sigh"}],` +
+				`"map":[{"_key":"chani","_value":{"int1":5,"string1":"chani"}},` +
+				`{"_key":"mauddib","_value":{"int1":1,"string1":"mauddib"}}]}` + "\n",
+		},
+		{
+			name:  "filter",
+			files: []string{example("TestOrcFile.test1.orc")},
+			opts:  options{columns: []string{"byte1"}, where: "boolean1 = true and bytes1 is not null", limit: -1},
+			want:  `{"byte1":100}` + "\n",
+		},
+		{
+			name:  "union and timestamp",
+			files: []string{example("TestOrcFile.testUnionAndTimestamp.orc")},
+			opts:  options{offset: 7, limit: 1},
+			want:  `{"time":"1971-05-05 12:34:56.1971","union":{"tag":1,"value":"3884841"},"decimal":293642287871.6627023}` + "\n",
+		},
+		{
+			name:  "stripes",
+			files: []string{example("TestOrcFile.testStripeLevelStats.orc")},
+			opts:  options{stripes: map[int]bool{0: true, 2: true}, offset: 4999, limit: 2},
+			want:  `{"int1":1,"string1":"one"}` + "\n" + `{"int1":3,"string1":"three"}` + "\n",
+		},
+		{
+			name:  "files",
+			files: []string{example("TestOrcFile.test1.orc"), example("TestOrcFile.test1.orc")},
+			opts:  options{columns: []string{"byte1"}, where: "byte1 > 1", limit: -1},
+			want:  `{"byte1":100}` + "\n" + `{"byte1":100}` + "\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			p := &printer{out: bufio.NewWriter(&buf), opts: tc.opts}
+			for _, filename := range tc.files {
+				if err := p.cat(filename); err != nil {
+					t.Fatal(err)
+				}
+			}
+			p.out.Flush()
+			if got := buf.String(); got != tc.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCatErrors(t *testing.T) {
+	for _, opts := range []options{
+		{columns: []string{"missing"}},
+		{columns: []string{"list._elem.int1"}},
+		{where: "int1 > x"},
+		{where: "middle = 1"},
+		{where: "bytes1 is"},
+	} {
+		p := &printer{out: bufio.NewWriter(&bytes.Buffer{}), opts: opts}
+		if err := p.cat(example("TestOrcFile.test1.orc")); err == nil {
+			t.Errorf("%+v: got no error", opts)
+		}
+	}
+}
+
+func TestParseStripes(t *testing.T) {
+	got, err := parseStripes("0, 2-4,3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[int]bool{0: true, 2: true, 3: true, 4: true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+	for _, s := range []string{"x", "-1", "3-1", "1-"} {
+		if _, err := parseStripes(s); err == nil {
+			t.Errorf("%q: got no error", s)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// gorc-head prints the first rows of ORC files as JSON Lines, decoding only the stripes holding them.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/graphaelli/gorc"
+	"github.com/graphaelli/gorc/internal/jsonl"
+)
+
+var n = flag.Int("n", 10, "number of rows to print of each file")
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] <filename> [<filename> ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+// head prints the first n rows of a file
+func head(out *bufio.Writer, filename string, n uint64) error {
+	f, err := orc.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	types := f.Footer.GetTypes()
+	if len(types) == 0 {
+		return nil
+	}
+
+	for i, info := range f.Footer.GetStripes() {
+		if n == 0 {
+			break
+		}
+		if info.GetNumberOfRows() == 0 {
+			continue
+		}
+		batch, err := f.ReadStripe(i)
+		if err != nil {
+			return err
+		}
+		for r := 0; r < batch.NumRows && n > 0; r++ {
+			if err := jsonl.WriteRow(out, types, batch.Row(r)); err != nil {
+				return err
+			}
+			n--
+		}
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if len(flag.Args()) < 1 {
+		flag.Usage()
+		return
+	}
+	if *n < 0 {
+		log.Fatalf("invalid number of rows %d", *n)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	for _, filename := range flag.Args() {
+		if err := head(out, filename, uint64(*n)); err != nil {
+			out.Flush()
+			log.Fatalf("%s: %v", filename, err)
+		}
+	}
+	if err := out.Flush(); err != nil {
+		log.Fatalln(err)
+	}
+}
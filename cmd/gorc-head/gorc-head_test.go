@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHead(t *testing.T) {
+	// the file has stripes of 5000, 5000 and 1000 rows
+	filename := filepath.Join("..", "..", "examples", "TestOrcFile.testStripeLevelStats.orc")
+	for _, tc := range []struct {
+		n           uint64
+		lines       int
+		first, last string
+	}{
+		{0, 0, "", ""},
+		{2, 2, `{"int1":1,"string1":"one"}`, `{"int1":1,"string1":"one"}`},
+		{5001, 5001, `{"int1":1,"string1":"one"}`, `{"int1":2,"string1":"two"}`},
+		{20000, 11000, `{"int1":1,"string1":"one"}`, `{"int1":3,"string1":"three"}`},
+	} {
+		var buf bytes.Buffer
+		out := bufio.NewWriter(&buf)
+		if err := head(out, filename, tc.n); err != nil {
+			t.Fatal(err)
+		}
+		out.Flush()
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		if tc.lines == 0 {
+			lines = nil
+		}
+		if len(lines) != tc.lines {
+			t.Errorf("-n %d: got %d lines; want %d", tc.n, len(lines), tc.lines)
+			continue
+		}
+		if tc.lines > 0 && (lines[0] != tc.first || lines[len(lines)-1] != tc.last) {
+			t.Errorf("-n %d: got lines from %s to %s", tc.n, lines[0], lines[len(lines)-1])
+		}
+	}
+
+	if err := head(bufio.NewWriter(&bytes.Buffer{}), "missing.orc", 1); err == nil {
+		t.Error("got no error reading a missing file")
+	}
+}
@@ -1,19 +1,26 @@
+// gorc-metadata prints the metadata of ORC files as JSON, in the layout of the Java tools' `orc-tools meta --json`
+// run in UTC, so that either tool can serve scripts reading it.
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/graphaelli/gorc"
 )
 
 var (
-	verbose = flag.Bool("verbose", false, "include additional metadata")
+	pretty  = flag.Bool("pretty", false, "indent the JSON")
+	verbose = flag.Bool("verbose", false, "deprecated: streams and encodings are always included")
 )
 
 func init() {
@@ -23,56 +30,529 @@ func init() {
 	}
 }
 
-func stripes(infos []*orc.StripeInformation, f *orc.File, verbose bool) []map[string]interface{} {
-	var j []map[string]interface{}
+// fileMeta is the metadata of a file, its fields in the order of the Java tools
+type fileMeta struct {
+	FileName              string             `json:"fileName"`
+	FileVersion           string             `json:"fileVersion"`
+	WriterVersion         string             `json:"writerVersion"`
+	NumberOfRows          uint64             `json:"numberOfRows"`
+	Compression           string             `json:"compression"`
+	CompressionBufferSize uint64             `json:"compressionBufferSize,omitempty"`
+	SchemaString          string             `json:"schemaString"`
+	Schema                *schema            `json:"schema"`
+	Calendar              string             `json:"calendar"`
+	StripeStatistics      []stripeStatistics `json:"stripeStatistics"`
+	FileStatistics        []columnStatistics `json:"fileStatistics"`
+	Stripes               []stripe           `json:"stripes"`
+	FileLength            int64              `json:"fileLength"`
+	PaddingLength         uint64             `json:"paddingLength"`
+	PaddingRatio          float64            `json:"paddingRatio"`
+	NumInserts            *int64             `json:"numInserts,omitempty"`
+	NumDeletes            *int64             `json:"numDeletes,omitempty"`
+	NumUpdates            *int64             `json:"numUpdates,omitempty"`
+	UserMetadata          map[string]string  `json:"userMetadata"`
+	Status                string             `json:"status"`
+}
+
+// failedMeta stands for a file that cannot be read
+type failedMeta struct {
+	FileName string `json:"fileName"`
+	Status   string `json:"status"`
+}
+
+type schema struct {
+	ColumnID   uint32            `json:"columnId"`
+	ColumnType string            `json:"columnType"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Precision  *uint32           `json:"precision,omitempty"`
+	Scale      *uint32           `json:"scale,omitempty"`
+	MaxLength  *uint32           `json:"maxLength,omitempty"`
+	// Children holds the fields of structs and the []*schema of the children of other compound types
+	Children interface{} `json:"children,omitempty"`
+}
+
+type field struct {
+	name   string
+	schema *schema
+}
+
+// fields is the fields of a struct, written as an object keeping their order
+type fields []field
+
+func (fs fields) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range fs {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := marshal(f.name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := marshal(f.schema)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+type stripeStatistics struct {
+	StripeNumber     int                `json:"stripeNumber"`
+	ColumnStatistics []columnStatistics `json:"columnStatistics"`
+}
+
+// columnStatistics is the statistics of a column, the fields present depending on its kind as in the Java tools
+type columnStatistics struct {
+	ColumnID      int         `json:"columnId"`
+	Count         uint64      `json:"count"`
+	HasNull       bool        `json:"hasNull"`
+	BytesOnDisk   uint64      `json:"bytesOnDisk,omitempty"`
+	TrueCount     *uint64     `json:"trueCount,omitempty"`
+	FalseCount    *uint64     `json:"falseCount,omitempty"`
+	Min           interface{} `json:"min,omitempty"`
+	LowerBound    *string     `json:"lowerBound,omitempty"`
+	Max           interface{} `json:"max,omitempty"`
+	UpperBound    *string     `json:"upperBound,omitempty"`
+	Sum           interface{} `json:"sum,omitempty"`
+	TotalLength   *int64      `json:"totalLength,omitempty"`
+	MinChildren   *uint64     `json:"minChildren,omitempty"`
+	MaxChildren   *uint64     `json:"maxChildren,omitempty"`
+	TotalChildren *uint64     `json:"totalChildren,omitempty"`
+	Type          string      `json:"type,omitempty"`
+}
+
+type stripe struct {
+	StripeNumber      int               `json:"stripeNumber"`
+	StripeInformation stripeInformation `json:"stripeInformation"`
+	Streams           []stream          `json:"streams"`
+	Encodings         []encoding        `json:"encodings"`
+}
+
+type stripeInformation struct {
+	Offset       uint64 `json:"offset"`
+	IndexLength  uint64 `json:"indexLength"`
+	DataLength   uint64 `json:"dataLength"`
+	FooterLength uint64 `json:"footerLength"`
+	RowCount     uint64 `json:"rowCount"`
+}
+
+type stream struct {
+	ColumnID    uint32 `json:"columnId"`
+	Section     string `json:"section"`
+	StartOffset uint64 `json:"startOffset"`
+	Length      uint64 `json:"length"`
+}
+
+type encoding struct {
+	ColumnID       int     `json:"columnId"`
+	Kind           string  `json:"kind"`
+	DictionarySize *uint32 `json:"dictionarySize,omitempty"`
+}
+
+// javaWriterVersions names the versions of Java writers as the Java tools do
+var javaWriterVersions = map[orc.WriterVersion]string{
+	orc.WRITER_ORIGINAL: "ORIGINAL",
+	orc.HIVE_8732:       "HIVE_8732",
+	orc.HIVE_4243:       "HIVE_4243",
+	orc.HIVE_12055:      "HIVE_12055",
+	orc.HIVE_13083:      "HIVE_13083",
+	orc.ORC_101:         "ORC_101",
+	orc.ORC_135:         "ORC_135",
+	orc.ORC_517:         "ORC_517",
+	orc.ORC_203:         "ORC_203",
+	orc.ORC_14:          "ORC_14",
+}
+
+// originalWriterVersions names the first version of other writers, the only one the Java tools know
+var originalWriterVersions = map[orc.WriterImplementation]string{
+	orc.ORC_CPP:       "ORC_CPP_ORIGINAL",
+	orc.PRESTO:        "PRESTO_ORIGINAL",
+	orc.SCRITCHLEY_GO: "SCRITCHLEY_GO_ORIGINAL",
+	orc.TRINO:         "TRINO_ORIGINAL",
+	orc.CUDF:          "CUDF_ORIGINAL",
+}
 
-	for i, info := range infos {
+func writerVersion(f *orc.File) string {
+	name, ok := javaWriterVersions[f.WriterVersion()]
+	if f.Writer() != orc.ORC_JAVA {
+		name, ok = originalWriterVersions[f.Writer()]
+		ok = ok && f.WriterVersion() == orc.ORC_135
+	}
+	if !ok {
+		return "FUTURE"
+	}
+	return name
+}
+
+// fileVersion names the format version as the Java tools do, which know no versions but 0.11 and 0.12
+func fileVersion(f *orc.File) string {
+	switch version := f.FormatVersion(); version {
+	case "0.11", "0.12":
+		return version
+	}
+	return "future"
+}
+
+func describeSchema(f *orc.File, id uint32) *schema {
+	t := f.Footer.GetTypes()[id]
+	s := &schema{ColumnID: id, ColumnType: t.GetKind().String()}
+	if attributes := f.TypeAttributes(id); len(attributes) > 0 {
+		s.Attributes = attributes
+	}
+	switch t.GetKind() {
+	case orc.Type_DECIMAL:
+		precision, scale := uint32(orc.DEFAULT_DECIMAL_PRECISION), uint32(orc.DEFAULT_DECIMAL_SCALE)
+		if t.Precision != nil {
+			precision, scale = t.GetPrecision(), t.GetScale()
+		}
+		s.Precision, s.Scale = &precision, &scale
+	case orc.Type_CHAR, orc.Type_VARCHAR:
+		// the Java tools default to 256 characters
+		length := uint32(256)
+		if t.MaximumLength != nil {
+			length = t.GetMaximumLength()
+		}
+		s.MaxLength = &length
+	}
+
+	var children []*schema
+	for _, sub := range t.GetSubtypes() {
+		// subtypes follow their parent, which rules out cycles
+		if sub > id && int(sub) < len(f.Footer.GetTypes()) {
+			children = append(children, describeSchema(f, sub))
+		}
+	}
+	switch t.GetKind() {
+	case orc.Type_STRUCT:
+		fs := fields{}
+		for i, child := range children {
+			if i < len(t.GetFieldNames()) {
+				fs = append(fs, field{name: t.GetFieldNames()[i], schema: child})
+			}
+		}
+		s.Children = fs
+	case orc.Type_LIST, orc.Type_MAP, orc.Type_UNION:
+		if children == nil {
+			children = []*schema{}
+		}
+		s.Children = children
+	}
+	return s
+}
+
+// javaDate formats the days since 1970-01-01 as the Java tools print dates, as java.util.Date in UTC
+func javaDate(days int32) string {
+	return time.Unix(int64(days)*24*60*60, 0).UTC().Format("Mon Jan 02 15:04:05 MST 2006")
+}
+
+// javaTimestamp formats the milliseconds of a timestamp and the nanoseconds after them, stored plus one, as
+// java.sql.Timestamp in UTC prints them
+func javaTimestamp(millis int64, nanos *int32) string {
+	t := time.UnixMilli(millis).UTC()
+	if nanos != nil {
+		t = t.Add(time.Duration(*nanos - 1))
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", t.Nanosecond()), "0")
+	if fraction == "" {
+		fraction = "0"
+	}
+	return t.Format("2006-01-02 15:04:05") + "." + fraction
+}
+
+// jsonFloat returns f, unless JSON cannot hold it
+func jsonFloat(f float64) interface{} {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return f
+}
+
+// describeStatistics decodes statistics as the Java tools do, taking the kind from the statistics present and the
+// defaults of the Java library for those missing
+func describeStatistics(id int, s *orc.ColumnStatistics) columnStatistics {
+	cs := columnStatistics{ColumnID: id, Count: s.GetNumberOfValues(), HasNull: s.HasNull == nil || s.GetHasNull(),
+		BytesOnDisk: s.GetBytesOnDisk()}
+	switch {
+	case s.BucketStatistics != nil:
+		var trues uint64
+		if count := s.GetBucketStatistics().GetCount(); len(count) > 0 {
+			trues = count[0]
+		}
+		falses := cs.Count - trues
+		cs.TrueCount, cs.FalseCount = &trues, &falses
+		cs.Type = orc.Type_BOOLEAN.String()
+	case s.IntStatistics != nil:
+		is := s.GetIntStatistics()
+		min, max := int64(math.MaxInt64), int64(math.MinInt64)
+		if is.Minimum != nil {
+			min = is.GetMinimum()
+		}
+		if is.Maximum != nil {
+			max = is.GetMaximum()
+		}
+		cs.Min, cs.Max = min, max
+		if is.Sum != nil {
+			cs.Sum = is.GetSum()
+		}
+		cs.Type = orc.Type_LONG.String()
+	case s.CollectionStatistics != nil:
+		c := s.GetCollectionStatistics()
+		min, max, total := c.GetMinChildren(), c.GetMaxChildren(), c.GetTotalChildren()
+		cs.MinChildren, cs.MaxChildren, cs.TotalChildren = &min, &max, &total
+	case s.DoubleStatistics != nil:
+		ds := s.GetDoubleStatistics()
+		min, max := math.MaxFloat64, math.SmallestNonzeroFloat64
+		if ds.Minimum != nil {
+			min = ds.GetMinimum()
+		}
+		if ds.Maximum != nil {
+			max = ds.GetMaximum()
+		}
+		cs.Min, cs.Max, cs.Sum = jsonFloat(min), jsonFloat(max), jsonFloat(ds.GetSum())
+		cs.Type = orc.Type_DOUBLE.String()
+	case s.StringStatistics != nil:
+		ss := s.GetStringStatistics()
+		if ss.Minimum != nil {
+			cs.Min = ss.GetMinimum()
+		} else {
+			cs.LowerBound = ss.LowerBound
+		}
+		if ss.Maximum != nil {
+			cs.Max = ss.GetMaximum()
+		} else {
+			cs.UpperBound = ss.UpperBound
+		}
+		sum := ss.GetSum()
+		cs.TotalLength = &sum
+		cs.Type = orc.Type_STRING.String()
+	case s.DecimalStatistics != nil:
+		ds := s.GetDecimalStatistics()
+		if ds.Maximum != nil {
+			cs.Min, cs.Max = ds.GetMinimum(), ds.GetMaximum()
+			cs.Sum = json.RawMessage("null")
+			if ds.Sum != nil {
+				cs.Sum = ds.GetSum()
+			}
+		}
+		cs.Type = orc.Type_DECIMAL.String()
+	case s.DateStatistics != nil:
+		ds := s.GetDateStatistics()
+		if ds.Maximum != nil {
+			cs.Min, cs.Max = javaDate(ds.GetMinimum()), javaDate(ds.GetMaximum())
+		}
+		cs.Type = orc.Type_DATE.String()
+	case s.TimestampStatistics != nil:
+		ts := s.GetTimestampStatistics()
+		// in UTC, the wall clock time since ORC-135 and the instants before read the same
+		min, max := ts.Minimum, ts.Maximum
+		if ts.MaximumUtc != nil {
+			min, max = ts.MinimumUtc, ts.MaximumUtc
+		}
+		if max != nil {
+			cs.Min = javaTimestamp(*min, ts.MinimumNanos)
+			cs.Max = javaTimestamp(*max, ts.MaximumNanos)
+		}
+		cs.Type = orc.Type_TIMESTAMP.String()
+	case s.BinaryStatistics != nil:
+		sum := s.GetBinaryStatistics().GetSum()
+		cs.TotalLength = &sum
+		cs.Type = orc.Type_BINARY.String()
+	}
+	return cs
+}
+
+func describeColumns(stats []*orc.ColumnStatistics) []columnStatistics {
+	columns := []columnStatistics{}
+	for i, s := range stats {
+		columns = append(columns, describeStatistics(i, s))
+	}
+	return columns
+}
+
+func describeStripes(f *orc.File) ([]stripe, error) {
+	stripes := []stripe{}
+	for i, info := range f.Footer.GetStripes() {
+		footer, err := f.GetStripeFooter(info)
+		if err != nil {
+			return nil, err
+		}
+		s := stripe{
+			StripeNumber: i + 1,
+			StripeInformation: stripeInformation{
+				Offset:       info.GetOffset(),
+				IndexLength:  info.GetIndexLength(),
+				DataLength:   info.GetDataLength(),
+				FooterLength: info.GetFooterLength(),
+				RowCount:     info.GetNumberOfRows(),
+			},
+			Streams:   []stream{},
+			Encodings: []encoding{},
+		}
 		offset := info.GetOffset()
-		si := map[string]interface{}{
-			"data":   info.GetDataLength(),
-			"footer": info.GetFooterLength(),
-			"index":  info.GetIndexLength(),
-			"length": info.GetIndexLength() + info.GetDataLength() + info.GetFooterLength(),
-			"offset": offset,
-			"rows":   info.GetNumberOfRows(),
-			"stripe": i,
-		}
-
-		if verbose {
-			if footer, err := f.GetStripeFooter(info); err != nil {
-				si["encodings"] = err
-			} else {
-				encodings := []map[string]interface{}{}
-				for c, col := range footer.GetColumns() {
-					encodings = append(encodings, map[string]interface{}{
-						"column":   c,
-						"encoding": col.GetKind().String(),
-						"count":    col.GetDictionarySize(),
-					})
-				}
-				si["encodings"] = encodings
-
-				streams := []map[string]interface{}{}
-				for c, col := range footer.GetStreams() {
-					length := col.GetLength()
-					streams = append(streams, map[string]interface{}{
-						"column": col.GetColumn(),
-						"id":     c,
-						"kind":   col.GetKind().String(),
-						"length": length,
-						"offset": offset,
-					})
-					offset += length
-				}
-				si["streams"] = streams
-
-				si["timezone"] = footer.GetWriterTimezone()
+		for _, st := range footer.GetStreams() {
+			section := "UNKNOWN"
+			if st.Kind != nil {
+				section = st.GetKind().String()
+			}
+			s.Streams = append(s.Streams, stream{ColumnID: st.GetColumn(), Section: section, StartOffset: offset,
+				Length: st.GetLength()})
+			offset += st.GetLength()
+		}
+		for c, col := range footer.GetColumns() {
+			e := encoding{ColumnID: c, Kind: col.GetKind().String()}
+			if col.GetKind() == orc.ColumnEncoding_DICTIONARY || col.GetKind() == orc.ColumnEncoding_DICTIONARY_V2 {
+				size := col.GetDictionarySize()
+				e.DictionarySize = &size
 			}
+			s.Encodings = append(s.Encodings, e)
 		}
+		stripes = append(stripes, s)
+	}
+	return stripes, nil
+}
+
+// padding returns the bytes between the stripes of the file
+func padding(f *orc.File) uint64 {
+	var padded uint64
+	stripes := f.Footer.GetStripes()
+	for i := 1; i < len(stripes); i++ {
+		prev := stripes[i-1]
+		end := prev.GetOffset() + prev.GetIndexLength() + prev.GetDataLength() + prev.GetFooterLength()
+		padded += stripes[i].GetOffset() - end
+	}
+	return padded
+}
 
-		j = append(j, si)
+// acidStats parses the counts of inserts, updates and deletes Hive records for transactional tables
+func acidStats(f *orc.File) []int64 {
+	value, ok := f.UserMetadataValue("hive.acid.stats")
+	if !ok {
+		return nil
+	}
+	parts := strings.Split(string(value), ",")
+	if len(parts) < 3 {
+		return nil
+	}
+	counts := make([]int64, 3)
+	for i := range counts {
+		n, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil {
+			return nil
+		}
+		counts[i] = n
 	}
-	return j
+	return counts
+}
+
+func describe(filename string, f *orc.File) (*fileMeta, error) {
+	m := &fileMeta{
+		FileName:         filename,
+		FileVersion:      fileVersion(f),
+		WriterVersion:    writerVersion(f),
+		NumberOfRows:     f.Footer.GetNumberOfRows(),
+		Compression:      f.PostScript.GetCompression().String(),
+		Calendar:         "Julian/Gregorian",
+		StripeStatistics: []stripeStatistics{},
+		FileStatistics:   describeColumns(f.Footer.GetStatistics()),
+		FileLength:       f.Length(),
+		PaddingLength:    padding(f),
+		UserMetadata:     map[string]string{},
+		Status:           "OK",
+	}
+	if m.Compression != orc.CompressionKind_NONE.String() {
+		m.CompressionBufferSize = f.PostScript.GetCompressionBlockSize()
+		if f.PostScript.CompressionBlockSize == nil {
+			m.CompressionBufferSize = 256 * 1024
+		}
+	}
+	if len(f.Footer.GetTypes()) > 0 {
+		m.SchemaString = orc.TypeString(f.Footer.GetTypes(), 0)
+		m.Schema = describeSchema(f, 0)
+	}
+	if f.Calendar() == orc.CalendarKind_PROLEPTIC_GREGORIAN {
+		m.Calendar = "proleptic Gregorian"
+	}
+
+	// statistics are printed as stored, as they are for the whole file
+	metadata, err := f.Metadata()
+	if err != nil {
+		return nil, err
+	}
+	for i, s := range metadata.GetStripeStats() {
+		m.StripeStatistics = append(m.StripeStatistics, stripeStatistics{StripeNumber: i + 1,
+			ColumnStatistics: describeColumns(s.GetColStats())})
+	}
+	if m.Stripes, err = describeStripes(f); err != nil {
+		return nil, err
+	}
+
+	if m.FileLength > 0 {
+		m.PaddingRatio = float64(m.PaddingLength) / float64(m.FileLength) * 100
+	}
+	if counts := acidStats(f); counts != nil {
+		m.NumInserts, m.NumUpdates, m.NumDeletes = &counts[0], &counts[1], &counts[2]
+	}
+	for name, value := range f.UserMetadata() {
+		m.UserMetadata[name] = string(value)
+	}
+	return m, nil
+}
+
+// marshal encodes v without escaping HTML, as the Java tools do
+func marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// dump writes the metadata of the files, a single file as an object and several as an array like the Java tools.
+// Files that cannot be read are logged and reported with a FAILED status, ok being false.
+func dump(out io.Writer, filenames []string, indent bool) (ok bool, err error) {
+	ok = true
+	var metas []interface{}
+	for _, filename := range filenames {
+		f, err := orc.Open(filename)
+		var m *fileMeta
+		if err == nil {
+			m, err = describe(filename, f)
+			f.Close()
+		}
+		if err != nil {
+			log.Printf("%s: %v", filename, err)
+			metas = append(metas, failedMeta{FileName: filename, Status: "FAILED"})
+			ok = false
+			continue
+		}
+		metas = append(metas, m)
+	}
+
+	var v interface{} = metas
+	if len(metas) == 1 {
+		v = metas[0]
+	}
+	data, err := marshal(v)
+	if err != nil {
+		return false, err
+	}
+	if indent {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return false, err
+		}
+		data = buf.Bytes()
+	}
+	_, err = out.Write(append(data, '\n'))
+	return ok, err
 }
 
 func main() {
@@ -81,41 +561,11 @@ func main() {
 		flag.Usage()
 		return
 	}
-
-	for _, filename := range flag.Args() {
-		o, err := orc.Open(filename)
-		if err != nil {
-			log.Fatalln(err)
-		}
-
-		var version []string
-		for _, v := range o.PostScript.GetVersion() {
-			version = append(version, strconv.FormatUint(uint64(v), 10))
-		}
-
-		metadata := map[string]interface{}{
-			"compression":       strings.ToLower(o.PostScript.Compression.String()),
-			"compression block": o.PostScript.CompressionBlockSize,
-			"content":           o.Footer.GetContentLength(),
-			"file length":       o.Length(),
-			"footer":            o.PostScript.GetFooterLength(),
-			"format":            strings.Join(version, "."),
-			"name":              filename,
-			"postscript":        o.PostScriptLength(),
-			"row index stride":  o.Footer.GetRowIndexStride(),
-			"rows":              o.Footer.GetNumberOfRows(),
-			"stripe count":      len(o.Footer.GetStripes()),
-			"stripe stats":      o.PostScript.GetMetadataLength(),
-			"stripes":           stripes(o.Footer.GetStripes(), o, *verbose),
-			"type":              fmt.Sprintf("%q", o.Footer.Types), // not identical
-			"user metadata":     o.Footer.GetMetadata(),            // not identical
-			"writer version":    o.WriterVersion(),
-		}
-
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		if err := enc.Encode(&metadata); err != nil {
-			log.Println(err)
-		}
+	ok, err := dump(os.Stdout, flag.Args(), *pretty)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if !ok {
+		os.Exit(1)
 	}
 }
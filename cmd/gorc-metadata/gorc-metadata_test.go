@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// checkGolden compares got to testdata/name, rewriting it instead with -update
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", name)
+	if *update {
+		if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got output differing from %s:\n%s", golden, got)
+	}
+}
+
+func TestDump(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	for _, name := range []string{
+		"TestOrcFile.emptyFile.orc",
+		"TestOrcFile.test1.orc",
+		"TestOrcFile.testStringAndBinaryStatistics.orc",
+		"TestOrcFile.testTimestamp.orc",
+		"TestOrcFile.testUnionAndTimestamp.orc",
+		"decimal.orc",
+		"orc-file-11-format.orc",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			ok, err := dump(&buf, []string{filepath.Join("..", "..", "examples", name)}, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				t.Error("got failure")
+			}
+			checkGolden(t, strings.TrimSuffix(name, ".orc")+".json", buf.Bytes())
+		})
+	}
+}
+
+func TestDumpFailed(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	var buf bytes.Buffer
+	ok, err := dump(&buf, []string{
+		filepath.Join("..", "..", "examples", "TestOrcFile.testTimestamp.orc"),
+		filepath.Join("..", "..", "examples", "TestVectorOrcFile.testLzo.orc"),
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("got success reading a file of unsupported compression")
+	}
+	checkGolden(t, "failed.json", buf.Bytes())
+}
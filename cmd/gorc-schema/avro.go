@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/graphaelli/gorc"
+)
+
+type avroRecord struct {
+	Type   string      `json:"type"`
+	Name   string      `json:"name"`
+	Fields []avroField `json:"fields"`
+}
+
+type avroField struct {
+	Name string      `json:"name"`
+	Type interface{} `json:"type"`
+	// Default is null, the first branch of the union of every field
+	Default json.RawMessage `json:"default"`
+	// Aliases holds the name of the column when it is not a valid Avro name
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+type avroLogical struct {
+	Type        string  `json:"type"`
+	LogicalType string  `json:"logicalType"`
+	Precision   *uint32 `json:"precision,omitempty"`
+	Scale       *uint32 `json:"scale,omitempty"`
+}
+
+type avroArray struct {
+	Type  string      `json:"type"`
+	Items interface{} `json:"items"`
+}
+
+type avroMap struct {
+	Type   string      `json:"type"`
+	Values interface{} `json:"values"`
+}
+
+// avroPrimitives are the Avro types of the primitive kinds that need no logical type
+var avroPrimitives = map[orc.Type_Kind]string{
+	orc.Type_BOOLEAN: "boolean",
+	orc.Type_BYTE:    "int",
+	orc.Type_SHORT:   "int",
+	orc.Type_INT:     "int",
+	orc.Type_LONG:    "long",
+	orc.Type_FLOAT:   "float",
+	orc.Type_DOUBLE:  "double",
+	orc.Type_STRING:  "string",
+	orc.Type_CHAR:    "string",
+	orc.Type_VARCHAR: "string",
+	orc.Type_BINARY:  "bytes",
+}
+
+// writeAvro writes an Avro schema of records of the columns of the root struct, mapping types as Hive's Avro SerDe
+// does: every value may be null, timestamps are in milliseconds, maps have string keys and unions are Avro unions
+func writeAvro(w io.Writer, types []*orc.Type, opts options) error {
+	if types[0].GetKind() != orc.Type_STRUCT {
+		return fmt.Errorf("the root column is a %s rather than a struct", orc.TypeString(types, 0))
+	}
+	record, err := avroType(types, 0, avroName(opts.name))
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", out)
+	return err
+}
+
+// avroType returns the Avro type of column id, name naming the record of a struct
+func avroType(types []*orc.Type, id uint32, name string) (interface{}, error) {
+	t := types[id]
+	if primitive, ok := avroPrimitives[t.GetKind()]; ok {
+		return primitive, nil
+	}
+	switch t.GetKind() {
+	case orc.Type_DATE:
+		return avroLogical{Type: "int", LogicalType: "date"}, nil
+	case orc.Type_TIMESTAMP, orc.Type_TIMESTAMP_INSTANT:
+		return avroLogical{Type: "long", LogicalType: "timestamp-millis"}, nil
+	case orc.Type_DECIMAL:
+		precision, scale := uint32(orc.DEFAULT_DECIMAL_PRECISION), uint32(orc.DEFAULT_DECIMAL_SCALE)
+		if t.Precision != nil {
+			precision, scale = t.GetPrecision(), t.GetScale()
+		}
+		return avroLogical{Type: "bytes", LogicalType: "decimal", Precision: &precision, Scale: &scale}, nil
+	case orc.Type_STRUCT:
+		record := avroRecord{Type: "record", Name: name, Fields: []avroField{}}
+		for _, c := range children(types, id) {
+			field := avroField{Name: avroName(c.name), Default: json.RawMessage("null")}
+			if field.Name != c.name {
+				field.Aliases = []string{c.name}
+			}
+			var err error
+			if field.Type, err = nullableAvroType(types, c.id, name+"_"+field.Name); err != nil {
+				return nil, err
+			}
+			record.Fields = append(record.Fields, field)
+		}
+		return record, nil
+	case orc.Type_LIST:
+		columns := children(types, id)
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("list column %d has %d children", id, len(columns))
+		}
+		items, err := nullableAvroType(types, columns[0].id, name+"_elem")
+		return avroArray{Type: "array", Items: items}, err
+	case orc.Type_MAP:
+		columns := children(types, id)
+		if len(columns) != 2 {
+			return nil, fmt.Errorf("map column %d has %d children", id, len(columns))
+		}
+		values, err := nullableAvroType(types, columns[1].id, name+"_value")
+		return avroMap{Type: "map", Values: values}, err
+	case orc.Type_UNION:
+		// Avro unions may not nest, so the variants join the null of the union itself
+		union := []interface{}{"null"}
+		for _, c := range children(types, id) {
+			variant, err := avroType(types, c.id, name+"_"+c.name)
+			if err != nil {
+				return nil, err
+			}
+			union = append(union, variant)
+		}
+		return union, nil
+	}
+	return nil, fmt.Errorf("column %d of kind %s has no Avro type", id, t.GetKind())
+}
+
+// nullableAvroType returns the union of null and the Avro type of column id
+func nullableAvroType(types []*orc.Type, id uint32, name string) (interface{}, error) {
+	t, err := avroType(types, id, name)
+	if err != nil {
+		return nil, err
+	}
+	if union, ok := t.([]interface{}); ok {
+		return union, nil
+	}
+	return []interface{}{"null", t}, nil
+}
+
+// avroName makes name a valid Avro name, replacing what is neither a letter, a digit nor an underscore by underscores
+// and prefixing an underscore to a leading digit
+func avroName(name string) string {
+	valid := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+	if valid == "" || valid[0] >= '0' && valid[0] <= '9' {
+		valid = "_" + valid
+	}
+	return valid
+}
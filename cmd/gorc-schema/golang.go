@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/graphaelli/gorc"
+)
+
+// goScalars are the Go types of the kinds of primitive columns, as orc.SchemaOf maps them back
+var goScalars = map[orc.Type_Kind]string{
+	orc.Type_BOOLEAN:           "bool",
+	orc.Type_BYTE:              "int8",
+	orc.Type_SHORT:             "int16",
+	orc.Type_INT:               "int32",
+	orc.Type_LONG:              "int64",
+	orc.Type_FLOAT:             "float32",
+	orc.Type_DOUBLE:            "float64",
+	orc.Type_STRING:            "string",
+	orc.Type_CHAR:              "string",
+	orc.Type_VARCHAR:           "string",
+	orc.Type_TIMESTAMP:         "time.Time",
+	orc.Type_DATE:              "time.Time",
+	orc.Type_TIMESTAMP_INSTANT: "time.Time",
+	orc.Type_DECIMAL:           "orc.Decimal",
+}
+
+// writeGo writes a Go struct for the rows of the file, tagged so that orc.SchemaOf and orc.TypedWriter derive the
+// schema back from it. The few types SchemaOf cannot derive, such as unions and the dates or chars of maps, are given
+// the nearest Go type: orc.Union for unions, and the type of their tag option for the others. With -pointers,
+// values are pointers so that they may be null, as slices and maps always may.
+func writeGo(w io.Writer, types []*orc.Type, opts options) error {
+	if types[0].GetKind() != orc.Type_STRUCT {
+		return fmt.Errorf("the root column is a %s rather than a struct", orc.TypeString(types, 0))
+	}
+	g := goWriter{types: types, pointers: opts.pointers}
+	src, err := format.Source([]byte(fmt.Sprintf("type %s %s", goName(opts.name), g.structType(0))))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", src)
+	return err
+}
+
+type goWriter struct {
+	types    []*orc.Type
+	pointers bool
+}
+
+// goType returns the Go type of the values of column id along with the tag option SchemaOf needs to derive its type
+func (g *goWriter) goType(id uint32, nullable bool) (string, string) {
+	t := g.types[id]
+	pointer := ""
+	if nullable && g.pointers {
+		pointer = "*"
+	}
+	switch t.GetKind() {
+	case orc.Type_BINARY:
+		return "[]byte", ""
+	case orc.Type_LIST:
+		for _, c := range children(g.types, id) {
+			elem, option := g.goType(c.id, true)
+			return "[]" + elem, option
+		}
+	case orc.Type_MAP:
+		if columns := children(g.types, id); len(columns) == 2 {
+			key, _ := g.goType(columns[0].id, false)
+			if key == "[]byte" {
+				// slices are not comparable
+				key = "string"
+			}
+			value, _ := g.goType(columns[1].id, true)
+			return "map[" + key + "]" + value, ""
+		}
+	case orc.Type_STRUCT:
+		return pointer + g.structType(id), ""
+	case orc.Type_UNION:
+		return pointer + "orc.Union", ""
+	case orc.Type_DATE:
+		return pointer + "time.Time", "date"
+	case orc.Type_CHAR, orc.Type_VARCHAR:
+		// as in the Java library, the length defaults to 256
+		length := uint32(256)
+		if t.MaximumLength != nil {
+			length = t.GetMaximumLength()
+		}
+		return pointer + "string", fmt.Sprintf("%s(%d)", strings.ToLower(t.GetKind().String()), length)
+	case orc.Type_DECIMAL:
+		precision, scale := uint32(orc.DEFAULT_DECIMAL_PRECISION), uint32(orc.DEFAULT_DECIMAL_SCALE)
+		if t.Precision != nil {
+			precision, scale = t.GetPrecision(), t.GetScale()
+		}
+		return pointer + "orc.Decimal", fmt.Sprintf("decimal(%d,%d)", precision, scale)
+	}
+	if scalar, ok := goScalars[t.GetKind()]; ok {
+		return pointer + scalar, ""
+	}
+	return "interface{}", ""
+}
+
+// structType returns the Go struct type of the struct column id, its fields named after the columns
+func (g *goWriter) structType(id uint32) string {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	used := make(map[string]bool)
+	for _, c := range children(g.types, id) {
+		name := goName(c.name)
+		for i := 2; used[name]; i++ {
+			name = goName(c.name) + strconv.Itoa(i)
+		}
+		used[name] = true
+
+		typ, option := g.goType(c.id, true)
+		tag := c.name
+		if option != "" {
+			tag += "," + option
+		}
+		tag = "orc:" + strconv.Quote(tag)
+		if strings.Contains(tag, "`") {
+			tag = strconv.Quote(tag)
+		} else {
+			tag = "`" + tag + "`"
+		}
+		fmt.Fprintf(&b, "%s %s %s\n", name, typ, tag)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// goName makes an exported Go identifier of name, dropping what is neither a letter nor a digit and capitalizing
+// the letter following it
+func goName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if b.Len() == 0 && unicode.IsDigit(r) {
+			b.WriteByte('F')
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "Field"
+	}
+	return b.String()
+}
@@ -0,0 +1,197 @@
+// gorc-schema prints the schema of ORC files as a Hive type, a Hive CREATE TABLE statement, a tree of columns, a
+// JSON Schema of the rows gorc-cat prints, an Avro schema or a Go struct the writer derives the schema back from.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/graphaelli/gorc"
+)
+
+var (
+	outputFormat = flag.String("format", "hive", "output format: hive, ddl, tree, json, avro or go")
+	name         = flag.String("name", "", "name of the table, record or Go type; derived from the file name by default")
+	location     = flag.String("location", "", "location of the table the ddl format creates")
+	pointers     = flag.Bool("pointers", false, "use pointers in Go structs for the values that may be null")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] <filename> [<filename> ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+// options configures the formats
+type options struct {
+	// name is the name of the table, record or Go type
+	name     string
+	location string
+	pointers bool
+}
+
+// formats write the schema of types in each format
+var formats = map[string]func(w io.Writer, types []*orc.Type, opts options) error{
+	"hive": writeHive,
+	"ddl":  writeDDL,
+	"tree": writeTree,
+	"json": writeJSONSchema,
+	"avro": writeAvro,
+	"go":   writeGo,
+}
+
+// column is a child of a compound column, named as File.FindColumn names it
+type column struct {
+	name string
+	id   uint32
+}
+
+// children returns the children of column id, leaving out those that do not follow it in pre-order as they would
+// make a cycle
+func children(types []*orc.Type, id uint32) []column {
+	t := types[id]
+	var columns []column
+	for i, sub := range t.GetSubtypes() {
+		if sub <= id || int(sub) >= len(types) {
+			continue
+		}
+		var name string
+		switch t.GetKind() {
+		case orc.Type_STRUCT:
+			if i < len(t.GetFieldNames()) {
+				name = t.GetFieldNames()[i]
+			}
+		case orc.Type_LIST:
+			name = "_elem"
+		case orc.Type_MAP:
+			name = []string{"_key", "_value"}[i%2]
+		default:
+			name = strconv.Itoa(i)
+		}
+		columns = append(columns, column{name, sub})
+	}
+	return columns
+}
+
+// isCompound reports whether columns of kind have children
+func isCompound(kind orc.Type_Kind) bool {
+	switch kind {
+	case orc.Type_STRUCT, orc.Type_LIST, orc.Type_MAP, orc.Type_UNION:
+		return true
+	}
+	return false
+}
+
+// writeHive writes the schema as a Hive type string
+func writeHive(w io.Writer, types []*orc.Type, opts options) error {
+	_, err := fmt.Fprintln(w, orc.TypeString(types, 0))
+	return err
+}
+
+// writeDDL writes a Hive statement creating an external table of the file's columns, those of a root of a kind
+// other than a struct being a single column named _col0 as Hive names it
+func writeDDL(w io.Writer, types []*orc.Type, opts options) error {
+	columns := []column{{"_col0", 0}}
+	if types[0].GetKind() == orc.Type_STRUCT {
+		columns = children(types, 0)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE EXTERNAL TABLE %s (\n", quote(opts.name))
+	for i, c := range columns {
+		fmt.Fprintf(&b, "  %s %s", quote(c.name), orc.TypeString(types, c.id))
+		if i < len(columns)-1 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString(")\nSTORED AS ORC")
+	if opts.location != "" {
+		fmt.Fprintf(&b, "\nLOCATION '%s'", strings.ReplaceAll(opts.location, "'", "\\'"))
+	}
+	b.WriteString(";\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// quote quotes an identifier with backticks for Hive
+func quote(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// writeTree writes a line for each column, its id in brackets followed by its name and its type, indented under its
+// parent
+func writeTree(w io.Writer, types []*orc.Type, opts options) error {
+	bw := bufio.NewWriter(w)
+	var walk func(c column, depth int)
+	walk = func(c column, depth int) {
+		kind := types[c.id].GetKind()
+		typ := orc.TypeString(types, c.id)
+		if isCompound(kind) {
+			typ = strings.SplitN(typ, "<", 2)[0]
+		}
+		fmt.Fprintf(bw, "%s[%d] ", strings.Repeat("  ", depth), c.id)
+		if c.name != "" {
+			fmt.Fprintf(bw, "%s ", c.name)
+		}
+		fmt.Fprintln(bw, typ)
+		for _, child := range children(types, c.id) {
+			walk(child, depth+1)
+		}
+	}
+	walk(column{id: 0}, 0)
+	return bw.Flush()
+}
+
+// defaultName derives a name from that of a file, replacing what is neither a letter nor a digit by underscores
+func defaultName(filename string) string {
+	base := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, base)
+}
+
+func main() {
+	flag.Parse()
+	if len(flag.Args()) < 1 {
+		flag.Usage()
+		return
+	}
+	write, ok := formats[*outputFormat]
+	if !ok {
+		log.Fatalf("unknown format %q", *outputFormat)
+	}
+
+	for i, filename := range flag.Args() {
+		f, err := orc.Open(filename)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		types := f.Footer.GetTypes()
+		f.Close()
+		if len(types) == 0 {
+			log.Fatalf("%s has no schema", filename)
+		}
+
+		opts := options{name: *name, location: *location, pointers: *pointers}
+		if opts.name == "" {
+			opts.name = defaultName(filename)
+		}
+		if i > 0 && *outputFormat != "hive" {
+			fmt.Println()
+		}
+		if err := write(os.Stdout, types, opts); err != nil {
+			log.Fatalf("%s: %v", filename, err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/graphaelli/gorc"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func TestFormats(t *testing.T) {
+	for _, name := range []string{
+		"TestOrcFile.test1.orc",
+		"TestOrcFile.testDate1900.orc",
+		"TestOrcFile.testUnionAndTimestamp.orc",
+	} {
+		f, err := orc.Open(filepath.Join("..", "..", "examples", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		types := f.Footer.GetTypes()
+		f.Close()
+
+		for format, write := range formats {
+			t.Run(name+"/"+format, func(t *testing.T) {
+				var buf bytes.Buffer
+				opts := options{name: defaultName(name), location: "s3://bucket/" + name}
+				if err := write(&buf, types, opts); err != nil {
+					t.Fatal(err)
+				}
+				golden := filepath.Join("testdata", strings.TrimSuffix(name, ".orc")+"."+format+".golden")
+				if *update {
+					if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+						t.Fatal(err)
+					}
+					return
+				}
+				want, err := ioutil.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(buf.Bytes(), want) {
+					t.Errorf("got output differing from %s:\n%s", golden, buf.Bytes())
+				}
+			})
+		}
+	}
+}
+
+func TestGoStruct(t *testing.T) {
+	f, err := orc.Open(filepath.Join("..", "..", "examples", "TestOrcFile.test1.orc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var buf bytes.Buffer
+	if err := writeGo(&buf, f.Footer.GetTypes(), options{name: "row", pointers: true}); err != nil {
+		t.Fatal(err)
+	}
+	src := "package p\n\n" + buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "row.go", src, 0); err != nil {
+		t.Fatalf("%v in\n%s", err, src)
+	}
+	for _, want := range []string{"type Row struct", "*int8", "`orc:\"byte1\"`", "map[string]*struct"} {
+		if !strings.Contains(src, want) {
+			t.Errorf("got no %q in\n%s", want, src)
+		}
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for name, want := range map[string]string{
+		"int1":       "Int1",
+		"_col0":      "Col0",
+		"first name": "FirstName",
+		"user_id":    "UserId",
+		"2fa":        "F2fa",
+		"--":         "Field",
+	} {
+		if got := goName(name); got != want {
+			t.Errorf("%q: got %q; want %q", name, got, want)
+		}
+	}
+}
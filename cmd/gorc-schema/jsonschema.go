@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/graphaelli/gorc"
+)
+
+// jsonSchema is a JSON Schema or one of its subschemas
+type jsonSchema map[string]interface{}
+
+// writeJSONSchema writes a JSON Schema of the lines gorc-cat, gorc-head and gorc-tail print for rows of the file
+func writeJSONSchema(w io.Writer, types []*orc.Type, opts options) error {
+	schema := jsonType(types, 0)
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["title"] = opts.name
+	out, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", out)
+	return err
+}
+
+// jsonType returns the schema of the values of column id as the commands write them
+func jsonType(types []*orc.Type, id uint32) jsonSchema {
+	t := types[id]
+	switch t.GetKind() {
+	case orc.Type_BOOLEAN:
+		return jsonSchema{"type": "boolean"}
+	case orc.Type_BYTE, orc.Type_SHORT, orc.Type_INT, orc.Type_LONG:
+		return jsonSchema{"type": "integer"}
+	case orc.Type_FLOAT, orc.Type_DOUBLE:
+		// JSON has no numbers for NaN and infinities, which are written as strings
+		return jsonSchema{"anyOf": []jsonSchema{{"type": "number"}, {"enum": []string{"NaN", "+Inf", "-Inf"}}}}
+	case orc.Type_STRING:
+		return jsonSchema{"type": "string"}
+	case orc.Type_CHAR, orc.Type_VARCHAR:
+		// as in the Java library, the length defaults to 256
+		length := uint32(256)
+		if t.MaximumLength != nil {
+			length = t.GetMaximumLength()
+		}
+		return jsonSchema{"type": "string", "maxLength": length}
+	case orc.Type_BINARY:
+		return jsonSchema{"type": "array", "items": jsonSchema{"type": "integer", "minimum": 0, "maximum": 255}}
+	case orc.Type_DATE:
+		return jsonSchema{"type": "string", "format": "date"}
+	case orc.Type_TIMESTAMP, orc.Type_TIMESTAMP_INSTANT:
+		return jsonSchema{"type": "string", "pattern": `^-?\d{4,}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(\.\d{1,9})?$`}
+	case orc.Type_DECIMAL:
+		return jsonSchema{"type": "number"}
+	case orc.Type_LIST:
+		schema := jsonSchema{"type": "array"}
+		for _, c := range children(types, id) {
+			schema["items"] = nullable(jsonType(types, c.id))
+		}
+		return schema
+	case orc.Type_MAP:
+		entry := jsonSchema{"type": "object", "properties": jsonSchema{}, "required": []string{},
+			"additionalProperties": false}
+		for _, c := range children(types, id) {
+			entry["properties"].(jsonSchema)[c.name] = jsonType(types, c.id)
+			entry["required"] = append(entry["required"].([]string), c.name)
+		}
+		// keys may not be null
+		if value, ok := entry["properties"].(jsonSchema)["_value"].(jsonSchema); ok {
+			entry["properties"].(jsonSchema)["_value"] = nullable(value)
+		}
+		return jsonSchema{"type": "array", "items": entry}
+	case orc.Type_STRUCT:
+		properties := jsonSchema{}
+		required := []string{}
+		for _, c := range children(types, id) {
+			properties[c.name] = nullable(jsonType(types, c.id))
+			required = append(required, c.name)
+		}
+		return jsonSchema{"type": "object", "properties": properties, "required": required,
+			"additionalProperties": false}
+	case orc.Type_UNION:
+		variants := []jsonSchema{}
+		for _, c := range children(types, id) {
+			tag, _ := strconv.Atoi(c.name)
+			variants = append(variants, jsonSchema{
+				"type":                 "object",
+				"properties":           jsonSchema{"tag": jsonSchema{"const": tag}, "value": nullable(jsonType(types, c.id))},
+				"required":             []string{"tag", "value"},
+				"additionalProperties": false,
+			})
+		}
+		return jsonSchema{"oneOf": variants}
+	}
+	return jsonSchema{}
+}
+
+// nullable returns a schema of the values of schema or null
+func nullable(schema jsonSchema) jsonSchema {
+	if t, ok := schema["type"].(string); ok {
+		schema["type"] = []string{t, "null"}
+		return schema
+	}
+	return jsonSchema{"anyOf": []jsonSchema{schema, {"type": "null"}}}
+}
@@ -1,49 +1,211 @@
 package main
 
 import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"math"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/graphaelli/gorc"
 )
 
+// masks collects the -mask flags
+type masks []orc.ColumnMask
+
+func (m *masks) String() string {
+	var s []string
+	for _, mask := range *m {
+		s = append(s, mask.Path+"="+mask.Kind.String())
+	}
+	return strings.Join(s, ",")
+}
+
+func (m *masks) Set(s string) error {
+	mask, err := orc.ParseColumnMask(s)
+	if err != nil {
+		return err
+	}
+	*m = append(*m, mask)
+	return nil
+}
+
+var (
+	columnMasks masks
+	verify      = flag.Bool("verify", false, "check the statistics against those of the values, exiting with 1 on any mismatch")
+	format      = flag.String("format", "text", "output format: text, json or csv")
+)
+
 func init() {
+	flag.Var(&columnMasks, "mask", "mask the statistics of a column as path=nullify|redact|sha256|keep-last-N, repeatable")
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: %s [options] <filename> [<filename> ...]\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 }
 
-func printColStats(col *orc.ColumnStatistics) {
-	if stats := col.GetBinaryStatistics(); stats != nil {
-		fmt.Printf("Data type: Binary\nValues: %d\nTotal Length: %d\n", col.GetNumberOfValues(), stats.GetSum())
-	} else if stats := col.GetBucketStatistics(); stats != nil {
-		trues := stats.GetCount()[0]
-		values := col.GetNumberOfValues()
-		fmt.Printf("Data type: Boolean\nValues: %d\n(true: %d; false: %d)\n", values, trues, values-trues)
-	} else if stats := col.GetDateStatistics(); stats != nil {
-		fmt.Printf("Data type: Date\nValues: %d\nMinimum: %d\nMaximum: %d\n", col.GetNumberOfValues(),
-			stats.GetMinimum(), stats.GetMaximum())
-	} else if stats := col.GetDecimalStatistics(); stats != nil {
-		fmt.Printf("Data type: Decimal\nValues: %d\nMinimum: %s\nMaximum: %s\nSum: %s\n", col.GetNumberOfValues(),
-			stats.GetMinimum(), stats.GetMaximum(), stats.GetSum())
-	} else if stats := col.GetDoubleStatistics(); stats != nil {
-		fmt.Printf("Data type: Double\nValues: %d\nMinimum: %f\nMaximum: %f\nSum: %f\n", col.GetNumberOfValues(),
-			stats.GetMinimum(), stats.GetMaximum(), stats.GetSum())
-	} else if stats := col.GetIntStatistics(); stats != nil {
-		fmt.Printf("Data type: Integer\nValues: %d\nMinimum: %d\nMaximum: %d\nSum: %d\n", col.GetNumberOfValues(),
-			stats.GetMinimum(), stats.GetMaximum(), stats.GetSum())
-	} else if stats := col.GetStringStatistics(); stats != nil {
-		fmt.Printf("Data type: String\nValues: %d\nMinimum: %s\nMaximum: %s\nTotal Length: %d\n", col.GetNumberOfValues(),
-			stats.GetMinimum(), stats.GetMaximum(), stats.GetSum())
-	} else if stats := col.GetTimestampStatistics(); stats != nil {
-		fmt.Printf("Data type: Timestamp\nValues: %d\nMinimum: %d\nMaximum: %d\n", col.GetNumberOfValues(),
-			stats.GetMinimum(), stats.GetMaximum())
-	} else {
-		fmt.Printf("Column has %d values\n", col.GetNumberOfValues())
+// columnStats is the statistics of a column as the formats write them, values being decoded to JSON friendly types
+type columnStats struct {
+	Column  int         `json:"column"`
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	Values  uint64      `json:"values"`
+	HasNull bool        `json:"hasNull"`
+	Minimum interface{} `json:"minimum,omitempty"`
+	Maximum interface{} `json:"maximum,omitempty"`
+	Sum     interface{} `json:"sum,omitempty"`
+}
+
+type stripeStats struct {
+	Stripe  int           `json:"stripe"`
+	Columns []columnStats `json:"columns"`
+}
+
+type fileStats struct {
+	Name    string        `json:"name"`
+	Columns []columnStats `json:"columns"`
+	Stripes []stripeStats `json:"stripes"`
+}
+
+// describe converts the statistics of each column, named after its path in the schema
+func describe(stats []orc.Stats, paths []string) []columnStats {
+	columns := make([]columnStats, len(stats))
+	for c, s := range stats {
+		columns[c] = columnStats{
+			Column:  c,
+			Type:    strings.ToLower(s.Kind().String()),
+			Values:  s.NumberOfValues(),
+			HasNull: s.HasNull(),
+			Minimum: value(s.Kind(), s.Min()),
+			Maximum: value(s.Kind(), s.Max()),
+			Sum:     value(s.Kind(), s.Sum()),
+		}
+		if c < len(paths) {
+			columns[c].Name = paths[c]
+		}
+	}
+	return columns
+}
+
+// value formats dates, timestamps and decimals as strings, and floating point numbers JSON cannot hold
+func value(kind orc.Type_Kind, v interface{}) interface{} {
+	switch v := v.(type) {
+	case time.Time:
+		if kind == orc.Type_DATE {
+			return v.Format("2006-01-02")
+		}
+		return v.Format("2006-01-02 15:04:05.999")
+	case orc.Decimal:
+		return v.String()
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return strconv.FormatFloat(v, 'g', -1, 64)
+		}
+	}
+	return v
+}
+
+func printText(stats fileStats) {
+	fmt.Printf("%s has %d columns\n", stats.Name, len(stats.Columns))
+	for _, col := range stats.Columns {
+		fmt.Printf("*** Column %d%s ***\n", col.Column, name(col))
+		printColStats(col)
+		fmt.Println()
+	}
+	fmt.Printf("%s has %d stripes\n", stats.Name, len(stats.Stripes))
+	for _, stripe := range stats.Stripes {
+		fmt.Printf("*** Stripe %d ***\n\n", stripe.Stripe)
+		for _, col := range stripe.Columns {
+			fmt.Printf("--- Column %d%s ---\n", col.Column, name(col))
+			printColStats(col)
+			fmt.Println()
+		}
+	}
+}
+
+// name returns the name of a column to follow its number, empty for the root column
+func name(col columnStats) string {
+	if col.Name == "" {
+		return ""
+	}
+	return ": " + col.Name
+}
+
+func printColStats(col columnStats) {
+	fmt.Printf("Data type: %s\nValues: %d\nHas null: %t\n", col.Type, col.Values, col.HasNull)
+	if col.Type == "boolean" {
+		if trues, ok := col.Sum.(uint64); ok {
+			fmt.Printf("(true: %d; false: %d)\n", trues, col.Values-trues)
+		} else {
+			fmt.Println("(true: unknown; false: unknown)")
+		}
+		return
+	}
+	if col.Minimum != nil || col.Maximum != nil {
+		fmt.Printf("Minimum: %v\nMaximum: %v\n", col.Minimum, col.Maximum)
+	}
+	if col.Sum != nil {
+		label := "Sum"
+		if col.Type == "string" || col.Type == "char" || col.Type == "varchar" || col.Type == "binary" {
+			label = "Total Length"
+		}
+		fmt.Printf("%s: %v\n", label, col.Sum)
+	}
+}
+
+var csvHeader = []string{"file", "stripe", "column", "name", "type", "values", "has_null", "minimum", "maximum", "sum"}
+
+// writeCSV writes a record for each column of the file and of each stripe, the stripe being empty for the file
+func writeCSV(w *csv.Writer, stats fileStats) error {
+	write := func(stripe string, columns []columnStats) error {
+		for _, col := range columns {
+			record := []string{stats.Name, stripe, strconv.Itoa(col.Column), col.Name, col.Type,
+				strconv.FormatUint(col.Values, 10), strconv.FormatBool(col.HasNull)}
+			for _, v := range []interface{}{col.Minimum, col.Maximum, col.Sum} {
+				field := ""
+				if v != nil {
+					field = fmt.Sprint(v)
+				}
+				record = append(record, field)
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := write("", stats.Columns); err != nil {
+		return err
+	}
+	for _, stripe := range stats.Stripes {
+		if err := write(strconv.Itoa(stripe.Stripe), stripe.Columns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyFile prints the statistics of f which differ from those of its values, reporting whether there are none
+func verifyFile(f *orc.File, filename string) (bool, error) {
+	mismatches, err := f.VerifyStatistics(context.Background())
+	if err != nil {
+		return false, err
+	}
+	for _, m := range mismatches {
+		fmt.Printf("%s: %s\n", filename, m)
 	}
+	if len(mismatches) > 0 {
+		return false, nil
+	}
+	fmt.Printf("%s has correct statistics\n", filename)
+	return true, nil
 }
 
 func main() {
@@ -52,36 +214,66 @@ func main() {
 		flag.Usage()
 		return
 	}
+	switch *format {
+	case "text", "json", "csv":
+	default:
+		log.Fatalf("unknown format %q", *format)
+	}
 
+	out := csv.NewWriter(os.Stdout)
+	if *format == "csv" && !*verify {
+		if err := out.Write(csvHeader); err != nil {
+			log.Fatalln(err)
+		}
+	}
+	failed := false
 	for _, filename := range flag.Args() {
-		o, err := orc.Open(filename)
+		o, err := orc.OpenWithOptions(filename, &orc.OpenOptions{Masks: columnMasks})
 		if err != nil {
 			log.Fatalln(err)
 		}
 
-		// TODO: hasCorrectStatistics?
-		colStats := o.GetStatistics()
-		fmt.Printf("%s has %d columns\n", filename, len(colStats))
-		for i, col := range colStats {
-			fmt.Printf("*** Column %d ***\n", i)
-			printColStats(col)
-			fmt.Println()
+		if *verify {
+			ok, err := verifyFile(o, filename)
+			o.Close()
+			if err != nil {
+				log.Printf("%s: %v", filename, err)
+			}
+			failed = failed || !ok || err != nil
+			continue
 		}
 
-		metadata := o.GetMetadata()
-		if metadata == nil {
-			log.Fatalln("failed to get metadata")
+		paths := o.ColumnPaths()
+		stats := fileStats{Name: filename, Columns: describe(o.ColumnStats(), paths), Stripes: []stripeStats{}}
+		stripes, err := o.StripeColumnStats()
+		if err != nil && !errors.Is(err, orc.ErrNoStripeStatistics) {
+			log.Fatalln(err)
+		}
+		for i, stripe := range stripes {
+			stats.Stripes = append(stats.Stripes, stripeStats{Stripe: i, Columns: describe(stripe, paths)})
 		}
-		stripeStats := metadata.GetStripeStats()
-		fmt.Printf("%s has %d stripes\n", filename, len(stripeStats))
-
-		for i, stripe := range stripeStats {
-			fmt.Printf("*** Stripe %d ***\n\n", i)
-			for c, col := range stripe.GetColStats() {
-				fmt.Printf("--- Column %d ---\n", c)
-				printColStats(col)
-				fmt.Println()
+		o.Close()
+
+		switch *format {
+		case "json":
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(&stats); err != nil {
+				log.Fatalln(err)
+			}
+		case "csv":
+			if err := writeCSV(out, stats); err != nil {
+				log.Fatalln(err)
 			}
+		default:
+			printText(stats)
 		}
 	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		log.Fatalln(err)
+	}
+	if failed {
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,87 @@
+// gorc-tail prints the last rows of ORC files as JSON Lines, finding the stripes holding them from the row counts of
+// the footer so as to decode only those.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/graphaelli/gorc"
+	"github.com/graphaelli/gorc/internal/jsonl"
+)
+
+var n = flag.Int("n", 10, "number of rows to print of each file")
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] <filename> [<filename> ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+// tail prints the last n rows of a file
+func tail(out *bufio.Writer, filename string, n uint64) error {
+	f, err := orc.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	types := f.Footer.GetTypes()
+	if len(types) == 0 || n == 0 {
+		return nil
+	}
+
+	// walk back from the last stripe until the stripes hold n rows, skipping those of the first beyond them
+	stripes := f.Footer.GetStripes()
+	first := len(stripes)
+	var rows, skip uint64
+	for first > 0 && rows < n {
+		first--
+		rows += stripes[first].GetNumberOfRows()
+	}
+	if rows > n {
+		skip = rows - n
+	}
+
+	for i := first; i < len(stripes); i++ {
+		if stripes[i].GetNumberOfRows() == 0 {
+			continue
+		}
+		batch, err := f.ReadStripe(i)
+		if err != nil {
+			return err
+		}
+		for r := skip; r < uint64(batch.NumRows); r++ {
+			if err := jsonl.WriteRow(out, types, batch.Row(int(r))); err != nil {
+				return err
+			}
+		}
+		skip = 0
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if len(flag.Args()) < 1 {
+		flag.Usage()
+		return
+	}
+	if *n < 0 {
+		log.Fatalf("invalid number of rows %d", *n)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	for _, filename := range flag.Args() {
+		if err := tail(out, filename, uint64(*n)); err != nil {
+			out.Flush()
+			log.Fatalf("%s: %v", filename, err)
+		}
+	}
+	if err := out.Flush(); err != nil {
+		log.Fatalln(err)
+	}
+}
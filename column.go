@@ -0,0 +1,544 @@
+package orc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"time"
+)
+
+// columnReader decodes the values of a single column from the streams of a stripe. Values are returned in the
+// representation documented on File.Rows, with nil for nulls.
+type columnReader interface {
+	next(n int) ([]interface{}, error)
+}
+
+// presence reads the optional PRESENT stream shared by every column kind
+type presence struct {
+	present *boolRLE
+}
+
+// read returns which of the next n values are not null, and how many there are. A nil slice means all present.
+func (p presence) read(n int) ([]bool, int, error) {
+	if p.present == nil {
+		return nil, n, nil
+	}
+	present := make([]bool, n)
+	count := 0
+	for i := range present {
+		ok, err := p.present.next()
+		if err != nil {
+			return nil, 0, fmt.Errorf("while reading present stream: %w", unexpected(err))
+		}
+		present[i] = ok
+		if ok {
+			count++
+		}
+	}
+	return present, count, nil
+}
+
+// values reads n values, calling value for each one that is not null
+func (p presence) values(n int, value func() (interface{}, error)) ([]interface{}, error) {
+	present, _, err := p.read(n)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, n)
+	for i := range values {
+		if present != nil && !present[i] {
+			continue
+		}
+		if values[i], err = value(); err != nil {
+			return nil, unexpected(err)
+		}
+	}
+	return values, nil
+}
+
+// scatter spreads the values read for present rows out to n rows
+func scatter(present []bool, n int, values []interface{}) []interface{} {
+	if present == nil {
+		return values
+	}
+	out := make([]interface{}, n)
+	j := 0
+	for i := range out {
+		if present[i] {
+			out[i] = values[j]
+			j++
+		}
+	}
+	return out
+}
+
+// newColumnReader builds the reader of column id and its children, masking their values as configured
+func (f *File) newColumnReader(s *stripe, id uint32) (columnReader, error) {
+	m := f.mask(id)
+	if m != nil && m.Kind == MASK_NULLIFY {
+		return nullColumn{}, nil
+	}
+	c, err := f.buildColumnReader(s, id)
+	if err != nil || m == nil {
+		return c, err
+	}
+	// masks of compound columns apply to their children
+	if t := f.Footer.GetTypes()[id]; len(t.GetSubtypes()) == 0 {
+		c = &maskedColumn{columnReader: c, t: t, mask: m}
+	}
+	return c, nil
+}
+
+func (f *File) buildColumnReader(s *stripe, id uint32) (columnReader, error) {
+	types := f.Footer.GetTypes()
+	if int(id) >= len(types) {
+		return nil, fmt.Errorf("column %d out of range of %d types", id, len(types))
+	}
+	t := types[id]
+	encoding := s.encoding(id)
+	p := s.presence(id)
+
+	switch t.GetKind() {
+	case Type_BOOLEAN:
+		return &boolColumn{p, newBoolRLE(s.stream(id, Stream_DATA))}, nil
+	case Type_BYTE:
+		return &byteColumn{p, newByteRLE(s.stream(id, Stream_DATA))}, nil
+	case Type_SHORT, Type_INT, Type_LONG:
+		return &intColumn{p, t.GetKind(), newIntDecoder(s.stream(id, Stream_DATA), encoding, true)}, nil
+	case Type_FLOAT:
+		return &floatColumn{p, s.stream(id, Stream_DATA), 4}, nil
+	case Type_DOUBLE:
+		return &floatColumn{p, s.stream(id, Stream_DATA), 8}, nil
+	case Type_STRING, Type_VARCHAR, Type_CHAR, Type_BINARY:
+		isBinary := t.GetKind() == Type_BINARY
+		switch encoding {
+		case ColumnEncoding_DICTIONARY, ColumnEncoding_DICTIONARY_V2:
+			dictionary, err := s.dictionary(id)
+			if err != nil {
+				return nil, err
+			}
+			return &dictionaryColumn{p, dictionary, newIntDecoder(s.stream(id, Stream_DATA), encoding, false), isBinary}, nil
+		}
+		return &bytesColumn{p, s.stream(id, Stream_DATA), newIntDecoder(s.stream(id, Stream_LENGTH), encoding, false), isBinary}, nil
+	case Type_TIMESTAMP:
+		return &timestampColumn{
+			presence: p,
+			seconds:  newIntDecoder(s.stream(id, Stream_DATA), encoding, true),
+			nanos:    newIntDecoder(s.stream(id, Stream_SECONDARY), encoding, false),
+			loc:      s.loc,
+			// timestamps are seconds since 2015-01-01 00:00:00 in the writer's timezone
+			base:   time.Date(2015, time.January, 1, 0, 0, 0, 0, s.loc).Unix(),
+			rebase: f.rebase,
+		}, nil
+	case Type_DATE:
+		return &dateColumn{p, newIntDecoder(s.stream(id, Stream_DATA), encoding, true), f.rebase}, nil
+	case Type_DECIMAL:
+		return &decimalColumn{p, s.stream(id, Stream_DATA), newIntDecoder(s.stream(id, Stream_SECONDARY), encoding, true)}, nil
+	}
+
+	children := make([]columnReader, len(t.GetSubtypes()))
+	for i, sub := range t.GetSubtypes() {
+		child, err := f.newColumnReader(s, sub)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = child
+	}
+
+	switch t.GetKind() {
+	case Type_STRUCT:
+		return &structColumn{p, children}, nil
+	case Type_LIST:
+		if len(children) != 1 {
+			return nil, fmt.Errorf("list column %d has %d subtypes", id, len(children))
+		}
+		return &listColumn{p, newIntDecoder(s.stream(id, Stream_LENGTH), encoding, false), children[0]}, nil
+	case Type_MAP:
+		if len(children) != 2 {
+			return nil, fmt.Errorf("map column %d has %d subtypes", id, len(children))
+		}
+		return &mapColumn{p, newIntDecoder(s.stream(id, Stream_LENGTH), encoding, false), children[0], children[1]}, nil
+	case Type_UNION:
+		return &unionColumn{p, newByteRLE(s.stream(id, Stream_DATA)), children}, nil
+	}
+	return nil, &UnsupportedError{Feature: "type " + t.GetKind().String()}
+}
+
+type boolColumn struct {
+	presence
+	data *boolRLE
+}
+
+func (c *boolColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		return c.data.next()
+	})
+}
+
+type byteColumn struct {
+	presence
+	data *byteRLE
+}
+
+func (c *byteColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		b, err := c.data.next()
+		return int8(b), err
+	})
+}
+
+type intColumn struct {
+	presence
+	kind Type_Kind
+	data intDecoder
+}
+
+func (c *intColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		v, err := c.data.next()
+		switch c.kind {
+		case Type_SHORT:
+			return int16(v), err
+		case Type_INT:
+			return int32(v), err
+		}
+		return v, err
+	})
+}
+
+type floatColumn struct {
+	presence
+	data  *bytes.Reader
+	width int
+}
+
+func (c *floatColumn) next(n int) ([]interface{}, error) {
+	buf := make([]byte, c.width)
+	return c.values(n, func() (interface{}, error) {
+		if _, err := io.ReadFull(c.data, buf); err != nil {
+			return nil, err
+		}
+		if c.width == 4 {
+			return math.Float32frombits(binary.LittleEndian.Uint32(buf)), nil
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(buf)), nil
+	})
+}
+
+// bytesColumn decodes directly encoded string, varchar, char and binary columns
+type bytesColumn struct {
+	presence
+	data    *bytes.Reader
+	lengths intDecoder
+	binary  bool
+}
+
+func (c *bytesColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		length, err := c.lengths.next()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(length) > uint64(c.data.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		buf := make([]byte, length)
+		if _, err := io.ReadFull(c.data, buf); err != nil {
+			return nil, err
+		}
+		if c.binary {
+			return buf, nil
+		}
+		return string(buf), nil
+	})
+}
+
+type dictionaryColumn struct {
+	presence
+	dictionary [][]byte
+	data       intDecoder
+	binary     bool
+}
+
+func (c *dictionaryColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		i, err := c.data.next()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(i) >= uint64(len(c.dictionary)) {
+			return nil, fmt.Errorf("dictionary index %d out of range of %d entries", i, len(c.dictionary))
+		}
+		if c.binary {
+			return c.dictionary[i], nil
+		}
+		return string(c.dictionary[i]), nil
+	})
+}
+
+type timestampColumn struct {
+	presence
+	seconds intDecoder
+	nanos   intDecoder
+	loc     *time.Location
+	base    int64
+	// rebase moves values to the dates they were written with in the hybrid calendar
+	rebase bool
+}
+
+func (c *timestampColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		seconds, err := c.seconds.next()
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := c.nanos.next()
+		if err != nil {
+			return nil, err
+		}
+		nanos := decodeNanos(uint64(encoded))
+		seconds += c.base
+		// writers truncate negative millis toward zero before splitting off the seconds
+		if seconds < 0 && nanos > 999999 {
+			seconds--
+		}
+		t := time.Unix(seconds, nanos).In(c.loc)
+		if c.rebase {
+			t = rebaseTime(t, true)
+		}
+		return t, nil
+	})
+}
+
+// decodeNanos expands nanoseconds stored with their trailing decimal zeros removed, the count of which is kept in
+// the low 3 bits
+func decodeNanos(encoded uint64) int64 {
+	zeros := encoded & 0x07
+	nanos := int64(encoded >> 3)
+	if zeros != 0 {
+		for i := uint64(0); i <= zeros; i++ {
+			nanos *= 10
+		}
+	}
+	return nanos
+}
+
+type dateColumn struct {
+	presence
+	data intDecoder
+	// rebase moves values to the dates they were written with in the hybrid calendar
+	rebase bool
+}
+
+func (c *dateColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		days, err := c.data.next()
+		if err != nil {
+			return nil, err
+		}
+		if c.rebase {
+			days = hybridToProleptic(days)
+		}
+		return time.Unix(days*secondsPerDay, 0).UTC(), nil
+	})
+}
+
+type decimalColumn struct {
+	presence
+	data  *bytes.Reader
+	scale intDecoder
+}
+
+func (c *decimalColumn) next(n int) ([]interface{}, error) {
+	return c.values(n, func() (interface{}, error) {
+		v, err := readBigVarint(c.data)
+		if err != nil {
+			return nil, err
+		}
+		scale, err := c.scale.next()
+		if err != nil {
+			return nil, err
+		}
+		return Decimal{Value: v, Scale: int(scale)}, nil
+	})
+}
+
+// readBigVarint reads an unbounded zigzag encoded base 128 varint
+func readBigVarint(r io.ByteReader) (*big.Int, error) {
+	v := new(big.Int)
+	var (
+		shift uint
+		digit big.Int
+	)
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF && shift > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		digit.SetUint64(uint64(b & 0x7f))
+		v.Or(v, digit.Lsh(&digit, shift))
+		if b < 0x80 {
+			break
+		}
+		shift += 7
+	}
+	negative := v.Bit(0) == 1
+	v.Rsh(v, 1)
+	if negative {
+		v.Neg(v).Sub(v, big.NewInt(1))
+	}
+	return v, nil
+}
+
+type structColumn struct {
+	presence
+	fields []columnReader
+}
+
+func (c *structColumn) next(n int) ([]interface{}, error) {
+	present, count, err := c.read(n)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([][]interface{}, len(c.fields))
+	for i, field := range c.fields {
+		if fields[i], err = field.next(count); err != nil {
+			return nil, err
+		}
+	}
+	values := make([]interface{}, count)
+	for i := range values {
+		row := make([]interface{}, len(fields))
+		for j := range fields {
+			row[j] = fields[j][i]
+		}
+		values[i] = row
+	}
+	return scatter(present, n, values), nil
+}
+
+// lengths reads the element counts of present list or map values
+func readLengths(d intDecoder, count int) ([]int, int, error) {
+	lengths := make([]int, count)
+	total := 0
+	for i := range lengths {
+		length, err := d.next()
+		if err != nil {
+			return nil, 0, fmt.Errorf("while reading lengths: %w", unexpected(err))
+		}
+		if length < 0 || length > math.MaxInt32 {
+			return nil, 0, fmt.Errorf("invalid length %d", length)
+		}
+		lengths[i] = int(length)
+		total += int(length)
+	}
+	return lengths, total, nil
+}
+
+type listColumn struct {
+	presence
+	lengths intDecoder
+	element columnReader
+}
+
+func (c *listColumn) next(n int) ([]interface{}, error) {
+	present, count, err := c.read(n)
+	if err != nil {
+		return nil, err
+	}
+	lengths, total, err := readLengths(c.lengths, count)
+	if err != nil {
+		return nil, err
+	}
+	elements, err := c.element.next(total)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, count)
+	for i, length := range lengths {
+		values[i] = elements[:length:length]
+		elements = elements[length:]
+	}
+	return scatter(present, n, values), nil
+}
+
+type mapColumn struct {
+	presence
+	lengths intDecoder
+	key     columnReader
+	value   columnReader
+}
+
+func (c *mapColumn) next(n int) ([]interface{}, error) {
+	present, count, err := c.read(n)
+	if err != nil {
+		return nil, err
+	}
+	lengths, total, err := readLengths(c.lengths, count)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := c.key.next(total)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := c.value.next(total)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, count)
+	j := 0
+	for i, length := range lengths {
+		entries := make([]MapEntry, length)
+		for k := range entries {
+			entries[k] = MapEntry{Key: keys[j], Value: vals[j]}
+			j++
+		}
+		values[i] = entries
+	}
+	return scatter(present, n, values), nil
+}
+
+type unionColumn struct {
+	presence
+	tags     *byteRLE
+	variants []columnReader
+}
+
+func (c *unionColumn) next(n int) ([]interface{}, error) {
+	present, count, err := c.read(n)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]int, count)
+	counts := make([]int, len(c.variants))
+	for i := range tags {
+		tag, err := c.tags.next()
+		if err != nil {
+			return nil, fmt.Errorf("while reading union tags: %w", unexpected(err))
+		}
+		if int(tag) >= len(c.variants) {
+			return nil, fmt.Errorf("union tag %d out of range of %d variants", tag, len(c.variants))
+		}
+		tags[i] = int(tag)
+		counts[tag]++
+	}
+	variants := make([][]interface{}, len(c.variants))
+	for i, variant := range c.variants {
+		if variants[i], err = variant.next(counts[i]); err != nil {
+			return nil, err
+		}
+	}
+	values := make([]interface{}, count)
+	for i, tag := range tags {
+		values[i] = Union{Tag: tag, Value: variants[tag][0]}
+		variants[tag] = variants[tag][1:]
+	}
+	return scatter(present, n, values), nil
+}
@@ -0,0 +1,1035 @@
+package orc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// columnWriter encodes the values of a single column, accepting the representations documented on File.Rows
+type columnWriter interface {
+	// write appends a value, nil for null
+	write(v interface{}) error
+	// flush completes the current stripe, adding the column's streams and encoding to s and resetting the writer
+	flush(s *stripeWriter)
+	// size estimates the bytes buffered for the current stripe
+	size() int64
+	// startGroup begins a row group, adding a row index entry holding the positions its values start at
+	startGroup()
+	// finishGroup completes the current row group, folding its statistics into those of the stripe
+	finishGroup()
+	// stripeStatistics returns the statistics of the row groups completed in the current stripe
+	stripeStatistics() *columnStats
+}
+
+// columnBase tracks nulls, the statistics and the indexes of a column
+type columnBase struct {
+	id      uint32
+	present *outStream
+	nulls   *boolWriter
+	// group accumulates the statistics of the current row group, stripe those of the stripe's completed row groups
+	group  *columnStats
+	stripe *columnStats
+	// entries holds the row index entries of the stripe, the first presentPositions positions of each being within
+	// the PRESENT stream
+	entries          []*RowIndexEntry
+	presentPositions int
+	// bloom is the bloom filter of the current row group when the column has them, blooms those of the stripe's
+	// completed row groups
+	bloom  *bloomFilter
+	blooms []*BloomFilter
+}
+
+func (w *Writer) newColumnBase(id uint32) columnBase {
+	stats := newColumnStats(w.types[id], w.loc)
+	c := columnBase{id: id, present: w.newStream(), group: stats, stripe: stats.empty()}
+	c.nulls = newBoolWriter(c.present)
+	if w.bloomFilters[id] {
+		c.bloom = newBloomFilter(w.opts.RowIndexStride, w.opts.BloomFilterFPP, w.loc)
+	}
+	return c
+}
+
+// writePresent records whether v is null, returning true if a value follows. Writers pass each value that follows
+// to update once it is validated.
+func (c *columnBase) writePresent(v interface{}) bool {
+	c.nulls.write(v != nil)
+	if v == nil {
+		c.group.null()
+		return false
+	}
+	return true
+}
+
+// update adds a value to the statistics and bloom filter of the row group
+func (c *columnBase) update(v interface{}) {
+	c.group.update(v)
+	if c.bloom != nil {
+		c.bloom.add(v)
+	}
+}
+
+// newEntry adds the row index entry of a row group, holding the position in the PRESENT stream. Writers append the
+// positions in their other streams.
+func (c *columnBase) newEntry() *RowIndexEntry {
+	e := &RowIndexEntry{Positions: c.nulls.position(nil)}
+	c.presentPositions = len(e.Positions)
+	c.entries = append(c.entries, e)
+	return e
+}
+
+func (c *columnBase) startGroup() {
+	c.newEntry()
+}
+
+func (c *columnBase) finishGroup() {
+	if n := len(c.entries); n > 0 {
+		c.entries[n-1].Statistics = c.group.statistics()
+	}
+	if c.bloom != nil {
+		c.blooms = append(c.blooms, c.bloom.message())
+		c.bloom.reset()
+	}
+	c.stripe.merge(c.group)
+	c.group = c.group.empty()
+}
+
+func (c *columnBase) stripeStatistics() *columnStats {
+	return c.stripe
+}
+
+// flushBase adds the column's indexes and its PRESENT stream, only when the stripe contained nulls. The positions
+// of the row index entries must be complete.
+func (c *columnBase) flushBase(s *stripeWriter) {
+	c.nulls.flush()
+	if c.stripe.hasNull {
+		s.addStream(c.id, Stream_PRESENT, c.present)
+	} else {
+		// positions are only given for the streams written
+		for _, e := range c.entries {
+			e.Positions = e.Positions[c.presentPositions:]
+		}
+	}
+	if len(c.entries) > 0 {
+		s.addIndex(c.id, Stream_ROW_INDEX, &RowIndex{Entry: c.entries})
+	}
+	if len(c.blooms) > 0 {
+		s.addIndex(c.id, Stream_BLOOM_FILTER_UTF8, &BloomFilterIndex{BloomFilter: c.blooms})
+	}
+	c.present.Reset()
+	c.entries = nil
+	c.blooms = nil
+	c.stripe = c.stripe.empty()
+}
+
+func (c *columnBase) size() int64 {
+	return int64(c.present.Len())
+}
+
+// newColumnWriter builds the writer for column id and its children, registering each in w.columns. The writers of
+// an encrypted column are registered in its encryption variant instead, w.columns holding the masked columns.
+func (w *Writer) newColumnWriter(id uint32) (columnWriter, error) {
+	var variant *writerVariant
+	for _, v := range w.variants {
+		if v.root != id {
+			continue
+		}
+		c, err := w.buildColumnWriter(id)
+		if err != nil {
+			return nil, err
+		}
+		// the masked writers built next replace those of the children
+		v.columns = append([]columnWriter{c}, w.columns[id+1:v.end]...)
+		variant = v
+	}
+	c, err := w.buildColumnWriter(id)
+	if err != nil {
+		return nil, err
+	}
+	w.columns[id] = c
+	if variant != nil {
+		return &encryptedColumnWriter{columnWriter: c, variant: variant}, nil
+	}
+	return c, nil
+}
+
+func (w *Writer) buildColumnWriter(id uint32) (columnWriter, error) {
+	t := w.types[id]
+	base := w.newColumnBase(id)
+	switch t.GetKind() {
+	case Type_BOOLEAN:
+		c := &boolColumnWriter{columnBase: base, buf: w.newStream()}
+		c.data = newBoolWriter(c.buf)
+		return c, nil
+	case Type_BYTE:
+		c := &byteColumnWriter{columnBase: base, buf: w.newStream()}
+		c.data = newByteRLEWriter(c.buf)
+		return c, nil
+	case Type_SHORT, Type_INT, Type_LONG:
+		c := &intColumnWriter{columnBase: base, kind: t.GetKind(), buf: w.newStream()}
+		c.data = newIntRLEv2Writer(c.buf, true)
+		return c, nil
+	case Type_FLOAT:
+		return &floatColumnWriter{columnBase: base, width: 4, buf: w.newStream()}, nil
+	case Type_DOUBLE:
+		return &floatColumnWriter{columnBase: base, width: 8, buf: w.newStream()}, nil
+	case Type_STRING, Type_VARCHAR, Type_CHAR:
+		c := &stringColumnWriter{
+			columnBase: base,
+			threshold:  w.opts.DictionaryKeySizeThreshold,
+			checkRows:  w.opts.DictionaryCheckRows,
+			direct:     w.opts.DictionaryKeySizeThreshold < 0,
+			index:      make(map[string]int),
+			data:       w.newStream(),
+			lengthBuf:  w.newStream(),
+			dictionary: w.newStream(),
+		}
+		c.lengths = newIntRLEv2Writer(c.lengthBuf, false)
+		return c, nil
+	case Type_BINARY:
+		c := &binaryColumnWriter{columnBase: base, buf: w.newStream(), lengthBuf: w.newStream()}
+		c.lengths = newIntRLEv2Writer(c.lengthBuf, false)
+		return c, nil
+	case Type_TIMESTAMP:
+		c := &timestampColumnWriter{
+			columnBase: base,
+			hybrid:     w.opts.HybridCalendar,
+			loc:        w.loc,
+			base:       time.Date(2015, time.January, 1, 0, 0, 0, 0, w.loc).Unix(),
+			secondsBuf: w.newStream(),
+			nanosBuf:   w.newStream(),
+		}
+		c.seconds = newIntRLEv2Writer(c.secondsBuf, true)
+		c.nanos = newIntRLEv2Writer(c.nanosBuf, false)
+		return c, nil
+	case Type_DATE:
+		c := &dateColumnWriter{columnBase: base, hybrid: w.opts.HybridCalendar, buf: w.newStream()}
+		c.data = newIntRLEv2Writer(c.buf, true)
+		return c, nil
+	case Type_DECIMAL:
+		c := &decimalColumnWriter{columnBase: base, scale: int(t.GetScale()), buf: w.newStream(), scaleBuf: w.newStream()}
+		c.scales = newIntRLEv2Writer(c.scaleBuf, true)
+		return c, nil
+	}
+
+	children := make([]columnWriter, len(t.GetSubtypes()))
+	for i, sub := range t.GetSubtypes() {
+		child, err := w.newColumnWriter(sub)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = child
+	}
+
+	switch t.GetKind() {
+	case Type_STRUCT:
+		return &structColumnWriter{columnBase: base, fields: children}, nil
+	case Type_LIST:
+		c := &listColumnWriter{columnBase: base, element: children[0], lengthBuf: w.newStream()}
+		c.lengths = newIntRLEv2Writer(c.lengthBuf, false)
+		return c, nil
+	case Type_MAP:
+		c := &mapColumnWriter{columnBase: base, key: children[0], value: children[1], lengthBuf: w.newStream()}
+		c.lengths = newIntRLEv2Writer(c.lengthBuf, false)
+		return c, nil
+	case Type_UNION:
+		c := &unionColumnWriter{columnBase: base, variants: children, buf: w.newStream()}
+		c.tags = newByteRLEWriter(c.buf)
+		return c, nil
+	}
+	return nil, fmt.Errorf("unsupported type: %s", t.GetKind())
+}
+
+// encryptedColumnWriter writes the values of an encrypted column to its encryption variant, and nulls to the masked
+// column in its place
+type encryptedColumnWriter struct {
+	columnWriter
+	variant *writerVariant
+}
+
+func (c *encryptedColumnWriter) write(v interface{}) error {
+	if err := c.variant.columns[0].write(v); err != nil {
+		return err
+	}
+	return c.columnWriter.write(nil)
+}
+
+func (c *encryptedColumnWriter) flush(s *stripeWriter) {
+	c.columnWriter.flush(s)
+	c.variant.columns[0].flush(s.variants[c.variant.id])
+}
+
+func (c *encryptedColumnWriter) size() int64 {
+	return c.columnWriter.size() + c.variant.columns[0].size()
+}
+
+var (
+	direct   = &ColumnEncoding{Kind: ColumnEncoding_DIRECT.Enum()}
+	directV2 = &ColumnEncoding{Kind: ColumnEncoding_DIRECT_V2.Enum()}
+)
+
+type boolColumnWriter struct {
+	columnBase
+	buf  *outStream
+	data *boolWriter
+}
+
+func (c *boolColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as boolean", c.id, v)
+	}
+	c.data.write(b)
+	c.update(b)
+	return nil
+}
+
+func (c *boolColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.data.position(e.Positions)
+}
+
+func (c *boolColumnWriter) flush(s *stripeWriter) {
+	c.data.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.setEncoding(c.id, direct)
+	c.buf.Reset()
+}
+
+func (c *boolColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.buf.Len())
+}
+
+type byteColumnWriter struct {
+	columnBase
+	buf  *outStream
+	data *byteRLEWriter
+}
+
+func (c *byteColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	i, err := toInt64(v, math.MinInt8, math.MaxInt8)
+	if err != nil {
+		return fmt.Errorf("column %d: %w", c.id, err)
+	}
+	c.data.write(byte(i))
+	c.update(i)
+	return nil
+}
+
+func (c *byteColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.data.position(e.Positions)
+}
+
+func (c *byteColumnWriter) flush(s *stripeWriter) {
+	c.data.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.setEncoding(c.id, direct)
+	c.buf.Reset()
+}
+
+func (c *byteColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.buf.Len())
+}
+
+type intColumnWriter struct {
+	columnBase
+	kind Type_Kind
+	buf  *outStream
+	data *intRLEv2Writer
+}
+
+func (c *intColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	min, max := int64(math.MinInt64), int64(math.MaxInt64)
+	switch c.kind {
+	case Type_SHORT:
+		min, max = math.MinInt16, math.MaxInt16
+	case Type_INT:
+		min, max = math.MinInt32, math.MaxInt32
+	}
+	i, err := toInt64(v, min, max)
+	if err != nil {
+		return fmt.Errorf("column %d: %w", c.id, err)
+	}
+	c.data.write(i)
+	c.update(i)
+	return nil
+}
+
+func (c *intColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.data.position(e.Positions)
+}
+
+func (c *intColumnWriter) flush(s *stripeWriter) {
+	c.data.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.setEncoding(c.id, directV2)
+	c.buf.Reset()
+}
+
+func (c *intColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.buf.Len())
+}
+
+type floatColumnWriter struct {
+	columnBase
+	width int
+	buf   *outStream
+}
+
+func (c *floatColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	var f float64
+	switch n := v.(type) {
+	case float32:
+		f = float64(n)
+	case float64:
+		f = n
+	default:
+		return fmt.Errorf("column %d: cannot write %T as floating point", c.id, v)
+	}
+	var buf [8]byte
+	if c.width == 4 {
+		f = float64(float32(f))
+		binary.LittleEndian.PutUint32(buf[:], math.Float32bits(float32(f)))
+	} else {
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(f))
+	}
+	c.buf.Write(buf[:c.width])
+	c.update(f)
+	return nil
+}
+
+func (c *floatColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.buf.position(e.Positions)
+}
+
+func (c *floatColumnWriter) flush(s *stripeWriter) {
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.setEncoding(c.id, direct)
+	c.buf.Reset()
+}
+
+func (c *floatColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.buf.Len())
+}
+
+// stringColumnWriter buffers a stripe of strings as a dictionary, choosing dictionary or direct encoding when the
+// stripe is flushed or, if the dictionary is already too large, once checkRows values are written
+type stringColumnWriter struct {
+	columnBase
+	threshold float64
+	checkRows int
+	// direct is set once the stripe is direct encoded, values then being written straight to data and lengths
+	direct bool
+	// keys holds the distinct values in the order first seen, index maps them back to their position
+	keys  []string
+	index map[string]int
+	rows  []int
+	bytes int64
+	// groups holds the number of values preceding each row group, whose positions are known once encoded, of which
+	// the first recorded have them
+	groups   []int
+	recorded int
+
+	data       *outStream
+	lengthBuf  *outStream
+	dictionary *outStream
+	lengths    *intRLEv2Writer
+}
+
+func (c *stringColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	var str string
+	switch s := v.(type) {
+	case string:
+		str = s
+	case []byte:
+		str = string(s)
+	default:
+		return fmt.Errorf("column %d: cannot write %T as string", c.id, v)
+	}
+	c.update(str)
+	if c.direct {
+		c.data.WriteString(str)
+		c.lengths.write(int64(len(str)))
+		return nil
+	}
+	i, ok := c.index[str]
+	if !ok {
+		i = len(c.keys)
+		c.index[str] = i
+		c.keys = append(c.keys, str)
+		c.bytes += int64(len(str))
+	}
+	c.rows = append(c.rows, i)
+	if len(c.rows) == c.checkRows && !c.useDictionary() {
+		c.writeDirect()
+	}
+	return nil
+}
+
+// useDictionary reports whether the values buffered are few enough distinct ones to be dictionary encoded
+func (c *stringColumnWriter) useDictionary() bool {
+	return len(c.rows) > 0 && float64(len(c.keys))/float64(len(c.rows)) <= c.threshold
+}
+
+// record appends positions to the entries of the row groups starting at or before value i
+func (c *stringColumnWriter) record(i int, position func([]uint64) []uint64) {
+	for ; c.recorded < len(c.groups) && c.groups[c.recorded] <= i; c.recorded++ {
+		e := c.entries[c.recorded]
+		e.Positions = position(e.Positions)
+	}
+}
+
+func (c *stringColumnWriter) directPosition(positions []uint64) []uint64 {
+	return c.lengths.position(c.data.position(positions))
+}
+
+// writeDirect switches the stripe to direct encoding, writing the values buffered and dropping the dictionary
+func (c *stringColumnWriter) writeDirect() {
+	for j, i := range c.rows {
+		c.record(j, c.directPosition)
+		c.data.WriteString(c.keys[i])
+		c.lengths.write(int64(len(c.keys[i])))
+	}
+	c.record(len(c.rows), c.directPosition)
+	c.direct = true
+	c.keys = nil
+	c.index = nil
+	c.rows = nil
+	c.bytes = 0
+}
+
+func (c *stringColumnWriter) startGroup() {
+	c.newEntry()
+	c.groups = append(c.groups, len(c.rows))
+	if c.direct {
+		c.record(0, c.directPosition)
+	}
+}
+
+func (c *stringColumnWriter) flush(s *stripeWriter) {
+	if !c.direct && c.useDictionary() {
+		// dictionary entries are written in sorted order
+		order := make([]int, len(c.keys))
+		for i := range order {
+			order[i] = i
+		}
+		sort.Slice(order, func(i, j int) bool { return c.keys[order[i]] < c.keys[order[j]] })
+		remap := make([]int64, len(c.keys))
+		for sorted, i := range order {
+			remap[i] = int64(sorted)
+			c.dictionary.WriteString(c.keys[i])
+			c.lengths.write(int64(len(c.keys[i])))
+		}
+		indexes := newIntRLEv2Writer(c.data, false)
+		for j, i := range c.rows {
+			c.record(j, indexes.position)
+			indexes.write(remap[i])
+		}
+		c.record(len(c.rows), indexes.position)
+		indexes.flush()
+		c.lengths.flush()
+		c.flushBase(s)
+		s.addStream(c.id, Stream_DATA, c.data)
+		s.addStream(c.id, Stream_LENGTH, c.lengthBuf)
+		s.addStream(c.id, Stream_DICTIONARY_DATA, c.dictionary)
+		size := uint32(len(c.keys))
+		s.setEncoding(c.id, &ColumnEncoding{Kind: ColumnEncoding_DICTIONARY_V2.Enum(), DictionarySize: &size})
+	} else {
+		if !c.direct {
+			c.writeDirect()
+		}
+		c.lengths.flush()
+		c.flushBase(s)
+		s.addStream(c.id, Stream_DATA, c.data)
+		s.addStream(c.id, Stream_LENGTH, c.lengthBuf)
+		s.setEncoding(c.id, directV2)
+	}
+
+	c.data.Reset()
+	c.lengthBuf.Reset()
+	c.dictionary.Reset()
+	c.direct = c.threshold < 0
+	c.keys = nil
+	c.index = make(map[string]int)
+	c.rows = nil
+	c.bytes = 0
+	c.groups = nil
+	c.recorded = 0
+}
+
+func (c *stringColumnWriter) size() int64 {
+	// distinct values plus a 4 byte index per row, or the streams once direct encoded
+	return c.columnBase.size() + c.bytes + 4*int64(len(c.rows)) + int64(c.data.Len()+c.lengthBuf.Len())
+}
+
+type binaryColumnWriter struct {
+	columnBase
+	buf       *outStream
+	lengthBuf *outStream
+	lengths   *intRLEv2Writer
+}
+
+func (c *binaryColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	var b []byte
+	switch value := v.(type) {
+	case []byte:
+		b = value
+	case string:
+		b = []byte(value)
+	default:
+		return fmt.Errorf("column %d: cannot write %T as binary", c.id, v)
+	}
+	c.buf.Write(b)
+	c.lengths.write(int64(len(b)))
+	c.update(b)
+	return nil
+}
+
+func (c *binaryColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.lengths.position(c.buf.position(e.Positions))
+}
+
+func (c *binaryColumnWriter) flush(s *stripeWriter) {
+	c.lengths.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.addStream(c.id, Stream_LENGTH, c.lengthBuf)
+	s.setEncoding(c.id, directV2)
+	c.buf.Reset()
+	c.lengthBuf.Reset()
+}
+
+func (c *binaryColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.buf.Len()+c.lengthBuf.Len())
+}
+
+type timestampColumnWriter struct {
+	columnBase
+	// hybrid moves values to the days with their dates in the hybrid calendar
+	hybrid     bool
+	loc        *time.Location
+	base       int64
+	secondsBuf *outStream
+	nanosBuf   *outStream
+	seconds    *intRLEv2Writer
+	nanos      *intRLEv2Writer
+}
+
+func (c *timestampColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	t, ok := v.(time.Time)
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as timestamp", c.id, v)
+	}
+	if c.hybrid {
+		t = rebaseTime(t, false)
+	}
+	// as the Java writer, split off the seconds of the millis truncated toward zero, which readers undo by subtracting
+	// a second from negative seconds with a millisecond or more of nanos. The seconds of the instants in the second
+	// before the epoch truncate to zero, so that they read a second later as in files of the Java writer, and are
+	// counted in the statistics as they read.
+	seconds := t.UnixMilli() / 1000
+	nanos := t.Nanosecond()
+	if seconds == 0 && t.Unix() < 0 {
+		t = t.Add(time.Second)
+	}
+	c.seconds.write(seconds - c.base)
+	c.nanos.write(int64(encodeNanos(nanos)))
+	c.update(t)
+	return nil
+}
+
+// encodeNanos removes trailing decimal zeros from nanoseconds, keeping their count in the low 3 bits
+func encodeNanos(nanos int) uint64 {
+	if nanos == 0 {
+		return 0
+	}
+	if nanos%100 != 0 {
+		return uint64(nanos) << 3
+	}
+	nanos /= 100
+	zeros := 1
+	for nanos%10 == 0 && zeros < 7 {
+		nanos /= 10
+		zeros++
+	}
+	return uint64(nanos)<<3 | uint64(zeros)
+}
+
+func (c *timestampColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.nanos.position(c.seconds.position(e.Positions))
+}
+
+func (c *timestampColumnWriter) flush(s *stripeWriter) {
+	c.seconds.flush()
+	c.nanos.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.secondsBuf)
+	s.addStream(c.id, Stream_SECONDARY, c.nanosBuf)
+	s.setEncoding(c.id, directV2)
+	c.secondsBuf.Reset()
+	c.nanosBuf.Reset()
+}
+
+func (c *timestampColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.secondsBuf.Len()+c.nanosBuf.Len())
+}
+
+type dateColumnWriter struct {
+	columnBase
+	// hybrid moves values to the days with their dates in the hybrid calendar
+	hybrid bool
+	buf    *outStream
+	data   *intRLEv2Writer
+}
+
+func (c *dateColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	t, ok := v.(time.Time)
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as date", c.id, v)
+	}
+	if c.hybrid {
+		t = rebaseTime(t, false)
+	}
+	days := daysSinceEpoch(t)
+	c.data.write(days)
+	c.update(days)
+	return nil
+}
+
+// daysSinceEpoch returns the days from 1970-01-01 to the calendar date of t in its own location
+func daysSinceEpoch(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+}
+
+func (c *dateColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.data.position(e.Positions)
+}
+
+func (c *dateColumnWriter) flush(s *stripeWriter) {
+	c.data.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.setEncoding(c.id, directV2)
+	c.buf.Reset()
+}
+
+func (c *dateColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.buf.Len())
+}
+
+type decimalColumnWriter struct {
+	columnBase
+	scale    int
+	buf      *outStream
+	scaleBuf *outStream
+	scales   *intRLEv2Writer
+}
+
+func (c *decimalColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	d, ok := v.(Decimal)
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as decimal", c.id, v)
+	}
+	d = d.Rescale(c.scale)
+	writeBigVarint(c.buf, d.Value)
+	c.scales.write(int64(d.Scale))
+	c.update(d)
+	return nil
+}
+
+// writeBigVarint writes an unbounded zigzag encoded base 128 varint
+func writeBigVarint(out *outStream, v *big.Int) {
+	u := new(big.Int).Lsh(v, 1)
+	if v.Sign() < 0 {
+		u.Neg(u).Sub(u, big.NewInt(1))
+	}
+	if u.IsUint64() {
+		writeUvarint(out, u.Uint64())
+		return
+	}
+	low := new(big.Int)
+	mask := big.NewInt(0x7f)
+	for u.Cmp(mask) > 0 {
+		out.WriteByte(byte(low.And(u, mask).Uint64()) | 0x80)
+		u.Rsh(u, 7)
+	}
+	out.WriteByte(byte(u.Uint64()))
+}
+
+func (c *decimalColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.scales.position(c.buf.position(e.Positions))
+}
+
+func (c *decimalColumnWriter) flush(s *stripeWriter) {
+	c.scales.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.addStream(c.id, Stream_SECONDARY, c.scaleBuf)
+	s.setEncoding(c.id, directV2)
+	c.buf.Reset()
+	c.scaleBuf.Reset()
+}
+
+func (c *decimalColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.buf.Len()+c.scaleBuf.Len())
+}
+
+type structColumnWriter struct {
+	columnBase
+	fields []columnWriter
+}
+
+func (c *structColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	values, ok := v.([]interface{})
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as struct", c.id, v)
+	}
+	if len(values) != len(c.fields) {
+		return fmt.Errorf("column %d: got %d values for struct of %d fields", c.id, len(values), len(c.fields))
+	}
+	c.update(v)
+	for i, field := range c.fields {
+		if err := field.write(values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *structColumnWriter) flush(s *stripeWriter) {
+	c.flushBase(s)
+	s.setEncoding(c.id, direct)
+	for _, field := range c.fields {
+		field.flush(s)
+	}
+}
+
+func (c *structColumnWriter) size() int64 {
+	size := c.columnBase.size()
+	for _, field := range c.fields {
+		size += field.size()
+	}
+	return size
+}
+
+type listColumnWriter struct {
+	columnBase
+	element   columnWriter
+	lengthBuf *outStream
+	lengths   *intRLEv2Writer
+}
+
+func (c *listColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	values, ok := v.([]interface{})
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as list", c.id, v)
+	}
+	c.update(v)
+	c.lengths.write(int64(len(values)))
+	for _, value := range values {
+		if err := c.element.write(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *listColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.lengths.position(e.Positions)
+}
+
+func (c *listColumnWriter) flush(s *stripeWriter) {
+	c.lengths.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_LENGTH, c.lengthBuf)
+	s.setEncoding(c.id, directV2)
+	c.lengthBuf.Reset()
+	c.element.flush(s)
+}
+
+func (c *listColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.lengthBuf.Len()) + c.element.size()
+}
+
+type mapColumnWriter struct {
+	columnBase
+	key       columnWriter
+	value     columnWriter
+	lengthBuf *outStream
+	lengths   *intRLEv2Writer
+}
+
+func (c *mapColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	entries, ok := v.([]MapEntry)
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as map", c.id, v)
+	}
+	c.update(v)
+	c.lengths.write(int64(len(entries)))
+	for _, entry := range entries {
+		if err := c.key.write(entry.Key); err != nil {
+			return err
+		}
+		if err := c.value.write(entry.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *mapColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.lengths.position(e.Positions)
+}
+
+func (c *mapColumnWriter) flush(s *stripeWriter) {
+	c.lengths.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_LENGTH, c.lengthBuf)
+	s.setEncoding(c.id, directV2)
+	c.lengthBuf.Reset()
+	c.key.flush(s)
+	c.value.flush(s)
+}
+
+func (c *mapColumnWriter) size() int64 {
+	return c.columnBase.size() + int64(c.lengthBuf.Len()) + c.key.size() + c.value.size()
+}
+
+type unionColumnWriter struct {
+	columnBase
+	variants []columnWriter
+	buf      *outStream
+	tags     *byteRLEWriter
+}
+
+func (c *unionColumnWriter) write(v interface{}) error {
+	if !c.writePresent(v) {
+		return nil
+	}
+	u, ok := v.(Union)
+	if !ok {
+		return fmt.Errorf("column %d: cannot write %T as union", c.id, v)
+	}
+	if u.Tag < 0 || u.Tag >= len(c.variants) {
+		return fmt.Errorf("column %d: union tag %d out of range of %d variants", c.id, u.Tag, len(c.variants))
+	}
+	c.tags.write(byte(u.Tag))
+	c.update(v)
+	return c.variants[u.Tag].write(u.Value)
+}
+
+func (c *unionColumnWriter) startGroup() {
+	e := c.newEntry()
+	e.Positions = c.tags.position(e.Positions)
+}
+
+func (c *unionColumnWriter) flush(s *stripeWriter) {
+	c.tags.flush()
+	c.flushBase(s)
+	s.addStream(c.id, Stream_DATA, c.buf)
+	s.setEncoding(c.id, direct)
+	c.buf.Reset()
+	for _, variant := range c.variants {
+		variant.flush(s)
+	}
+}
+
+func (c *unionColumnWriter) size() int64 {
+	size := c.columnBase.size() + int64(c.buf.Len())
+	for _, variant := range c.variants {
+		size += variant.size()
+	}
+	return size
+}
+
+// toInt64 converts any Go integer to an int64 within [min, max]
+func toInt64(v interface{}, min, max int64) (int64, error) {
+	var i int64
+	switch n := v.(type) {
+	case int:
+		i = int64(n)
+	case int8:
+		i = int64(n)
+	case int16:
+		i = int64(n)
+	case int32:
+		i = int64(n)
+	case int64:
+		i = n
+	case uint8:
+		i = int64(n)
+	case uint16:
+		i = int64(n)
+	case uint32:
+		i = int64(n)
+	case uint:
+		if uint64(n) > math.MaxInt64 {
+			return 0, fmt.Errorf("%d out of range", n)
+		}
+		i = int64(n)
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, fmt.Errorf("%d out of range", n)
+		}
+		i = int64(n)
+	default:
+		return 0, fmt.Errorf("cannot write %T as integer", v)
+	}
+	if i < min || i > max {
+		return 0, fmt.Errorf("%d out of range [%d, %d]", i, min, max)
+	}
+	return i, nil
+}
@@ -0,0 +1,588 @@
+package orc
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// KeyMetadata identifies a version of a master key
+type KeyMetadata struct {
+	Name      string
+	Version   uint32
+	Algorithm EncryptionAlgorithm
+}
+
+// KeyProvider holds the master keys that encrypt the local keys of encrypted columns, typically standing for a key
+// management service. Master keys never leave the provider.
+type KeyProvider interface {
+	// CurrentKey returns the version of the named master key that new files are encrypted with
+	CurrentKey(name string) (KeyMetadata, error)
+	// CreateLocalKey returns a new local key for the algorithm of key, both in the clear and encrypted by the
+	// master key
+	CreateLocalKey(key KeyMetadata) (local, encrypted []byte, err error)
+	// DecryptLocalKey decrypts a local key encrypted by key, returning a nil key when the master key is not
+	// available to the caller
+	DecryptLocalKey(key KeyMetadata, encrypted []byte) ([]byte, error)
+}
+
+// keyLength returns the length in bytes of the keys of an algorithm
+func keyLength(algorithm EncryptionAlgorithm) (int, error) {
+	switch algorithm {
+	case EncryptionAlgorithm_AES_CTR_128:
+		return 16, nil
+	case EncryptionAlgorithm_AES_CTR_256:
+		return 32, nil
+	}
+	return 0, &UnsupportedError{Feature: "encryption algorithm " + algorithm.String()}
+}
+
+// encryptionIV returns the initialization vector of a stream, holding its column, kind and stripe id followed by a
+// zero counter as every ORC implementation lays it out
+func encryptionIV(column uint32, kind Stream_Kind, stripeID uint64) []byte {
+	iv := make([]byte, aes.BlockSize)
+	iv[0], iv[1], iv[2] = byte(column>>16), byte(column>>8), byte(column)
+	iv[3], iv[4] = byte(kind>>8), byte(kind)
+	iv[5], iv[6], iv[7] = byte(stripeID>>16), byte(stripeID>>8), byte(stripeID)
+	return iv
+}
+
+// crypt encrypts or decrypts data with AES in counter mode, the two being the same operation
+func crypt(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(out, data)
+	return out, nil
+}
+
+// LocalKeyProvider is a KeyProvider holding its master keys in memory, as loaded from a key file. It suits tests
+// and tools rather than production, where keys belong in a key management service.
+type LocalKeyProvider struct {
+	// keys holds the versions of each master key in the order added, the last being current
+	keys map[string][]localMasterKey
+}
+
+type localMasterKey struct {
+	KeyMetadata
+	material []byte
+}
+
+// NewLocalKeyProvider returns a LocalKeyProvider without keys
+func NewLocalKeyProvider() *LocalKeyProvider {
+	return &LocalKeyProvider{keys: make(map[string][]localMasterKey)}
+}
+
+// LoadLocalKeyProvider reads master keys from a file holding one key per line as its name, version, algorithm and
+// base64 encoded key material, such as "pii 1 AES_CTR_128 3q2+7wAAAAAAAAAAAAAAAA==". Blank lines and lines starting
+// with # are ignored.
+func LoadLocalKeyProvider(filename string) (*LocalKeyProvider, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	p := NewLocalKeyProvider()
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("%s:%d: expected name, version, algorithm and key", filename, line)
+		}
+		version, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: version: %w", filename, line, err)
+		}
+		algorithm, ok := EncryptionAlgorithm_value[fields[2]]
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: unknown algorithm %s", filename, line, fields[2])
+		}
+		material, err := base64.StdEncoding.DecodeString(fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: key: %w", filename, line, err)
+		}
+		if err := p.AddKey(fields[0], uint32(version), EncryptionAlgorithm(algorithm), material); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filename, line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// AddKey adds a version of a master key, which becomes the current version of its name if it is the latest
+func (p *LocalKeyProvider) AddKey(name string, version uint32, algorithm EncryptionAlgorithm, material []byte) error {
+	length, err := keyLength(algorithm)
+	if err != nil {
+		return err
+	}
+	if len(material) != length {
+		return fmt.Errorf("key %s has %d bytes, %s takes %d", name, len(material), algorithm, length)
+	}
+	versions := p.keys[name]
+	for _, k := range versions {
+		if k.Version == version {
+			return fmt.Errorf("key %s version %d added twice", name, version)
+		}
+	}
+	key := localMasterKey{KeyMetadata{Name: name, Version: version, Algorithm: algorithm}, material}
+	if n := len(versions); n > 0 && versions[n-1].Version > version {
+		// keep the latest version last
+		versions = append(versions[:n-1], key, versions[n-1])
+	} else {
+		versions = append(versions, key)
+	}
+	p.keys[name] = versions
+	return nil
+}
+
+func (p *LocalKeyProvider) CurrentKey(name string) (KeyMetadata, error) {
+	versions := p.keys[name]
+	if len(versions) == 0 {
+		return KeyMetadata{}, fmt.Errorf("unknown key %s", name)
+	}
+	return versions[len(versions)-1].KeyMetadata, nil
+}
+
+// CreateLocalKey returns a random encrypted local key, decrypting it with the master key. The first bytes of the
+// encrypted key serve as the initialization vector, as in the Java InMemoryKeystore.
+func (p *LocalKeyProvider) CreateLocalKey(key KeyMetadata) ([]byte, []byte, error) {
+	length, err := keyLength(key.Algorithm)
+	if err != nil {
+		return nil, nil, err
+	}
+	encrypted := make([]byte, length)
+	if _, err := rand.Read(encrypted); err != nil {
+		return nil, nil, err
+	}
+	local, err := p.DecryptLocalKey(key, encrypted)
+	if err != nil {
+		return nil, nil, err
+	}
+	if local == nil {
+		return nil, nil, fmt.Errorf("unknown key %s version %d", key.Name, key.Version)
+	}
+	return local, encrypted, nil
+}
+
+func (p *LocalKeyProvider) DecryptLocalKey(key KeyMetadata, encrypted []byte) ([]byte, error) {
+	for _, k := range p.keys[key.Name] {
+		if k.Version != key.Version {
+			continue
+		}
+		if k.Algorithm != key.Algorithm {
+			return nil, fmt.Errorf("key %s version %d is %s, not %s", key.Name, key.Version, k.Algorithm, key.Algorithm)
+		}
+		if len(encrypted) < aes.BlockSize {
+			return nil, fmt.Errorf("encrypted local key of %d bytes", len(encrypted))
+		}
+		return crypt(k.material, encrypted[:aes.BlockSize], encrypted)
+	}
+	return nil, nil
+}
+
+// encryptionVariant is an encrypted column subtree of a file, with its keys when the key provider has its master key
+type encryptionVariant struct {
+	id uint32
+	// root and end delimit the columns of the variant, root to end excluded
+	root, end uint32
+	// footerKey decrypts the statistics of the variant, nil when the master key is not available
+	footerKey []byte
+	// stripeKeys holds the local key of each stripe
+	stripeKeys [][]byte
+}
+
+// loadEncryption finds the encrypted columns of the file and decrypts the keys of those provider has the master key
+// of, reading the statistics of the first variant of each column that can be decrypted
+func (f *File) loadEncryption(provider KeyProvider) error {
+	encryption := f.Footer.GetEncryption()
+	if encryption == nil {
+		return nil
+	}
+	types := f.Footer.GetTypes()
+	stripes := f.Footer.GetStripes()
+	f.variants = make([]*encryptionVariant, len(encryption.GetVariants()))
+	f.decrypted = make([]*encryptionVariant, len(types))
+	f.stripeIDs = make([]uint64, len(stripes))
+	var id uint64
+	for i, info := range stripes {
+		if info.EncryptStripeId != nil {
+			id = info.GetEncryptStripeId()
+		} else {
+			id++
+		}
+		f.stripeIDs[i] = id
+	}
+
+	keys := encryption.GetKey()
+	for v, variant := range encryption.GetVariants() {
+		root := variant.GetRoot()
+		if int(root) >= len(types) || int(variant.GetKey()) >= len(keys) {
+			return corrupt("footer", f.footerOffset(), fmt.Errorf("encryption variant %d of column %d and key %d",
+				v, root, variant.GetKey()))
+		}
+		ev := &encryptionVariant{id: uint32(v), root: root, end: subtreeEnd(types, root)}
+		f.variants[v] = ev
+		key := keys[variant.GetKey()]
+		meta := KeyMetadata{Name: key.GetKeyName(), Version: key.GetKeyVersion(), Algorithm: key.GetAlgorithm()}
+		if provider == nil || f.decrypted[root] != nil {
+			continue
+		}
+		if _, err := keyLength(meta.Algorithm); err != nil {
+			// a later variant of the column may use a known algorithm
+			continue
+		}
+		footerKey, err := provider.DecryptLocalKey(meta, variant.GetEncryptedKey())
+		if err != nil {
+			return fmt.Errorf("while decrypting the key of column %d: %w", root, err)
+		}
+		if footerKey == nil {
+			continue
+		}
+		ev.stripeKeys = make([][]byte, len(stripes))
+		var stripeKey []byte
+		for i, info := range stripes {
+			if encrypted := info.GetEncryptedLocalKeys(); v < len(encrypted) {
+				if stripeKey, err = provider.DecryptLocalKey(meta, encrypted[v]); err != nil {
+					return fmt.Errorf("while decrypting the key of column %d in stripe %d: %w", root, i, err)
+				}
+			}
+			if stripeKey == nil {
+				return corrupt("footer", f.footerOffset(), fmt.Errorf("no key for column %d in stripe %d", root, i))
+			}
+			ev.stripeKeys[i] = stripeKey
+		}
+		ev.footerKey = footerKey
+
+		if data := variant.GetFileStatistics(); data != nil {
+			var stats FileStatistics
+			if err := f.decodeEncrypted("footer", f.footerOffset(), data, ev.footerKey, root, Stream_FILE_STATISTICS,
+				&stats); err != nil {
+				return err
+			}
+			if len(stats.GetColumn()) == int(ev.end-root) && len(f.Footer.Statistics) == len(types) {
+				copy(f.Footer.Statistics[root:ev.end], stats.GetColumn())
+			}
+		}
+		for c := root; c < ev.end; c++ {
+			f.decrypted[c] = ev
+		}
+	}
+	return nil
+}
+
+// decryptStripeStatistics replaces the masked stripe statistics of the decrypted columns by their own, read from
+// before the metadata
+func (f *File) decryptStripeStatistics() error {
+	length := int64(f.PostScript.GetStripeStatisticsLength())
+	if length == 0 {
+		return nil
+	}
+	offset := f.footerOffset() - int64(f.PostScript.GetMetadataLength()) - length
+	var buf []byte
+	var start uint64
+	for v, variant := range f.Footer.GetEncryption().GetVariants() {
+		ev := f.variants[v]
+		for _, stream := range variant.GetStripeStatistics() {
+			at := start
+			start += stream.GetLength()
+			column := stream.GetColumn()
+			if ev.footerKey == nil || f.decrypted[ev.root] != ev {
+				continue
+			}
+			if buf == nil {
+				var err error
+				if buf, err = f.readSection("stripe statistics", offset, length); err != nil {
+					return err
+				}
+			}
+			if start > uint64(len(buf)) || start < at || column < ev.root || column >= ev.end {
+				return corrupt("stripe statistics", offset, fmt.Errorf("statistics of column %d", column))
+			}
+			var stats ColumnarStripeStatistics
+			if err := f.decodeEncrypted("stripe statistics", offset+int64(at), buf[at:start], ev.footerKey, column,
+				Stream_STRIPE_STATISTICS, &stats); err != nil {
+				return err
+			}
+			stripes := f.metadata.GetStripeStats()
+			if len(stats.GetColStats()) != len(stripes) {
+				return corrupt("stripe statistics", offset, fmt.Errorf("%d stripes in the statistics of column %d",
+					len(stats.GetColStats()), column))
+			}
+			for s, stripe := range stripes {
+				if int(column) < len(stripe.GetColStats()) {
+					stripe.ColStats[column] = stats.GetColStats()[s]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// decodeEncrypted decrypts, decompresses and unmarshals a section of the tail encrypted by key
+func (f *File) decodeEncrypted(section string, offset int64, data, key []byte, column uint32, kind Stream_Kind,
+	m proto.Message) error {
+	iv := encryptionIV(column, kind, uint64(len(f.Footer.GetStripes()))+1)
+	data, err := crypt(key, iv, data)
+	if err != nil {
+		return err
+	}
+	return f.decodeSection(section, offset, data, m)
+}
+
+// Masked reports whether a column is encrypted without the key provider having its key, reading it giving the
+// masked values the writer stored in its place
+func (f *File) Masked(column uint32) bool {
+	if f.decrypted == nil || int(column) >= len(f.decrypted) || f.decrypted[column] != nil {
+		return false
+	}
+	for _, ev := range f.variants {
+		if column >= ev.root && column < ev.end {
+			return true
+		}
+	}
+	return false
+}
+
+// stripeStream is a stream of a stripe at offset from the start of the stripe, encrypted by key unless it is nil
+type stripeStream struct {
+	*Stream
+	offset uint64
+	key    []byte
+}
+
+func isIndexStream(kind Stream_Kind) bool {
+	return kind == Stream_ROW_INDEX || kind == Stream_BLOOM_FILTER || kind == Stream_BLOOM_FILTER_UTF8
+}
+
+// stripeStreams lists the streams of stripe i, the streams of decrypted columns being taken from their encryption
+// variant rather than the masked streams, and replaces their encodings in footer likewise
+func (f *File) stripeStreams(i int, footer *StripeFooter) ([]stripeStream, error) {
+	info := f.Footer.GetStripes()[i]
+	var streams []stripeStream
+	var offset uint64
+	for _, stream := range footer.GetStreams() {
+		start := offset
+		offset += stream.GetLength()
+		kind := stream.GetKind()
+		column := stream.GetColumn()
+		if kind != Stream_ENCRYPTED_INDEX && kind != Stream_ENCRYPTED_DATA {
+			if f.decrypted == nil || int(column) >= len(f.decrypted) || f.decrypted[column] == nil {
+				streams = append(streams, stripeStream{Stream: stream, offset: start})
+			}
+			continue
+		}
+
+		if int(column) >= len(f.variants) || int(column) >= len(footer.GetEncryption()) {
+			return nil, corrupt("stripe", int64(info.GetOffset()), fmt.Errorf("stream %s of unknown encryption variant %d",
+				kind, column))
+		}
+		ev := f.variants[column]
+		if ev.footerKey == nil || f.decrypted[ev.root] != ev {
+			continue
+		}
+		variant := footer.GetEncryption()[column]
+		at := start
+		for _, s := range variant.GetStreams() {
+			if isIndexStream(s.GetKind()) != (kind == Stream_ENCRYPTED_INDEX) {
+				continue
+			}
+			if s.GetColumn() < ev.root || s.GetColumn() >= ev.end {
+				return nil, corrupt("stripe", int64(info.GetOffset()), fmt.Errorf(
+					"stream %s of column %d in encryption variant %d", s.GetKind(), s.GetColumn(), column))
+			}
+			streams = append(streams, stripeStream{Stream: s, offset: at, key: ev.stripeKeys[i]})
+			at += s.GetLength()
+		}
+		if at != offset {
+			return nil, corrupt("stripe", int64(info.GetOffset()), fmt.Errorf(
+				"streams of encryption variant %d do not fill its %s stream", column, kind))
+		}
+		if encodings := variant.GetEncoding(); len(encodings) > 0 {
+			if len(encodings) != int(ev.end-ev.root) || len(footer.GetColumns()) < int(ev.end) {
+				return nil, corrupt("stripe", int64(info.GetOffset()), fmt.Errorf(
+					"%d encodings for encryption variant %d", len(encodings), column))
+			}
+			copy(footer.Columns[ev.root:ev.end], encodings)
+		}
+	}
+	return streams, nil
+}
+
+// decryptStream decrypts a stream of stripe i read from the file, returning it unchanged when it is not encrypted
+func (f *File) decryptStream(i int, stream stripeStream, data []byte) ([]byte, error) {
+	if stream.key == nil {
+		return data, nil
+	}
+	return crypt(stream.key, encryptionIV(stream.GetColumn(), stream.GetKind(), f.stripeIDs[i]), data)
+}
+
+// subtreeEnd returns the id following the last column of the subtree of column root
+func subtreeEnd(types []*Type, root uint32) uint32 {
+	end := root + 1
+	for id := root; id < end && int(id) < len(types); id++ {
+		for _, sub := range types[id].GetSubtypes() {
+			if sub+1 > end {
+				end = sub + 1
+			}
+		}
+	}
+	if int(end) > len(types) {
+		end = uint32(len(types))
+	}
+	return end
+}
+
+// writerVariant accumulates the encryption variant of an encrypted column subtree, all of it encrypted by a single
+// local key
+type writerVariant struct {
+	id uint32
+	// root and end delimit the columns of the variant, root to end excluded
+	root, end uint32
+	// key is the index of the master key in Encryption.key
+	key              uint32
+	local, encrypted []byte
+	// columns holds the writers of the columns of the variant, from root
+	columns []columnWriter
+	// stripeStats holds the statistics of each column for each stripe, fileStats those of the file
+	stripeStats [][]*ColumnStatistics
+	fileStats   []*columnStats
+}
+
+// newWriterVariants creates the encryption variants of the encrypted columns of o, returning them in column order
+// with the master keys they use in name order
+func newWriterVariants(types []*Type, o *WriterOptions, loc *time.Location) ([]*writerVariant, []*EncryptionKey,
+	error) {
+	if len(o.EncryptedColumns) == 0 {
+		return nil, nil, nil
+	}
+	if o.KeyProvider == nil {
+		return nil, nil, fmt.Errorf("encrypted columns require a key provider")
+	}
+	roots := make([]uint32, 0, len(o.EncryptedColumns))
+	var names []string
+	seen := make(map[string]bool)
+	for root, name := range o.EncryptedColumns {
+		roots = append(roots, root)
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Slice(roots, func(i, j int) bool { return roots[i] < roots[j] })
+	sort.Strings(names)
+
+	keys := make([]*EncryptionKey, len(names))
+	metadata := make(map[string]KeyMetadata, len(names))
+	index := make(map[string]uint32, len(names))
+	for i, name := range names {
+		meta, err := o.KeyProvider.CurrentKey(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[i] = &EncryptionKey{KeyName: proto.String(meta.Name), KeyVersion: proto.Uint32(meta.Version),
+			Algorithm: meta.Algorithm.Enum()}
+		metadata[name] = meta
+		index[name] = uint32(i)
+	}
+
+	variants := make([]*writerVariant, len(roots))
+	for i, root := range roots {
+		switch {
+		case root == 0:
+			return nil, nil, fmt.Errorf("cannot encrypt the root column")
+		case int(root) >= len(types):
+			return nil, nil, fmt.Errorf("encrypted column %d not in schema", root)
+		case i > 0 && root < variants[i-1].end:
+			return nil, nil, fmt.Errorf("encrypted column %d is within encrypted column %d", root, variants[i-1].root)
+		}
+		name := o.EncryptedColumns[root]
+		meta := metadata[name]
+		length, err := keyLength(meta.Algorithm)
+		if err != nil {
+			return nil, nil, err
+		}
+		local, encrypted, err := o.KeyProvider.CreateLocalKey(meta)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(local) != length {
+			return nil, nil, fmt.Errorf("local key of %d bytes for key %s of %s", len(local), name, meta.Algorithm)
+		}
+		v := &writerVariant{
+			id:        uint32(i),
+			root:      root,
+			end:       subtreeEnd(types, root),
+			key:       index[name],
+			local:     local,
+			encrypted: encrypted,
+		}
+		v.stripeStats = make([][]*ColumnStatistics, v.end-root)
+		for id := root; id < v.end; id++ {
+			v.fileStats = append(v.fileStats, newColumnStats(types[id], loc))
+		}
+		variants[i] = v
+	}
+	return variants, keys, nil
+}
+
+// writeEncryption writes the encrypted stripe statistics of the encryption variants, returning the Encryption of
+// the footer with the number of bytes written
+func (w *Writer) writeEncryption() (*Encryption, uint64, error) {
+	if len(w.variants) == 0 {
+		return nil, 0, nil
+	}
+	// statistics are encrypted as if by a stripe following the last
+	stripeID := uint64(len(w.stripes)) + 1
+	mask := &DataMask{Name: proto.String("nullify")}
+	encryption := &Encryption{Mask: []*DataMask{mask}, Key: w.encryptionKeys}
+	var length uint64
+	for _, v := range w.variants {
+		variant := &EncryptionVariant{Root: proto.Uint32(v.root), Key: proto.Uint32(v.key), EncryptedKey: v.encrypted}
+		fileStats := make([]*ColumnStatistics, len(v.fileStats))
+		for j, stats := range v.stripeStats {
+			column := v.root + uint32(j)
+			n, err := w.writeEncryptedMessage(&ColumnarStripeStatistics{ColStats: stats}, v.local, column,
+				Stream_STRIPE_STATISTICS, stripeID)
+			if err != nil {
+				return nil, 0, err
+			}
+			variant.StripeStatistics = append(variant.StripeStatistics, &Stream{
+				Kind:   Stream_STRIPE_STATISTICS.Enum(),
+				Column: proto.Uint32(column),
+				Length: proto.Uint64(n),
+			})
+			length += n
+			fileStats[j] = v.fileStats[j].statistics()
+		}
+		buf, err := proto.Marshal(&FileStatistics{Column: fileStats})
+		if err != nil {
+			return nil, 0, err
+		}
+		iv := encryptionIV(v.root, Stream_FILE_STATISTICS, stripeID)
+		if variant.FileStatistics, err = crypt(v.local, iv, w.compress(buf)); err != nil {
+			return nil, 0, err
+		}
+		mask.Columns = append(mask.Columns, v.root)
+		encryption.Variants = append(encryption.Variants, variant)
+	}
+	return encryption, length, nil
+}
@@ -0,0 +1,227 @@
+package orc
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// testKeys returns a provider holding the pii key, and the credit key unless piiOnly
+func testKeys(t *testing.T, piiOnly bool) *LocalKeyProvider {
+	p := NewLocalKeyProvider()
+	if err := p.AddKey("pii", 1, EncryptionAlgorithm_AES_CTR_128, bytes.Repeat([]byte{1}, 16)); err != nil {
+		t.Fatal(err)
+	}
+	if !piiOnly {
+		if err := p.AddKey("credit", 3, EncryptionAlgorithm_AES_CTR_256, bytes.Repeat([]byte{2}, 32)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return p
+}
+
+// writeAllTypes writes rows of allTypes with the given options, returning the path of the file
+func writeAllTypes(t *testing.T, n int, opts *WriterOptions) string {
+	path := filepath.Join(t.TempDir(), "all.orc")
+	out, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	w, err := NewWriter(out, allTypes(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		if err := w.WriteRow(allTypesRow(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestEncryption(t *testing.T) {
+	const n = 5000
+	opts := WriterOptions{
+		Compression:          CompressionKind_ZLIB,
+		CompressionBlockSize: 1024,
+		StripeSize:           16 * 1024,
+		RowIndexStride:       1000,
+		BloomFilterColumns:   []uint32{8},
+	}
+	plain, err := Open(writeAllTypes(t, n, &opts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plain.Close()
+	plainStats, err := plain.StripeStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the string column and the struct holding an int
+	opts.EncryptedColumns = map[uint32]string{8: "pii", 20: "credit"}
+	opts.KeyProvider = testKeys(t, false)
+	path := writeAllTypes(t, n, &opts)
+
+	for _, tc := range []struct {
+		name     string
+		provider KeyProvider
+		// masked holds the masked fields of the root struct
+		masked map[int]bool
+	}{
+		{name: "all keys", provider: testKeys(t, false)},
+		{name: "pii key", provider: testKeys(t, true), masked: map[int]bool{16: true}},
+		{name: "no keys", provider: NewLocalKeyProvider(), masked: map[int]bool{7: true, 16: true}},
+		{name: "no provider", masked: map[int]bool{7: true, 16: true}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := OpenWithOptions(path, &OpenOptions{KeyProvider: tc.provider})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			if len(f.Footer.GetStripes()) < 2 {
+				t.Fatalf("got %d stripes; want several", len(f.Footer.GetStripes()))
+			}
+			for column, field := range map[uint32]int{8: 7, 20: 16, 21: 16, 7: -1} {
+				if got := f.Masked(column); got != tc.masked[field] {
+					t.Errorf("column %d masked: got %t; want %t", column, got, tc.masked[field])
+				}
+			}
+
+			for i, row := range readAll(t, f) {
+				want := allTypesRow(i)
+				for c := range want {
+					if tc.masked[c] {
+						want[c] = nil
+					}
+					if !sameValue(row[c], want[c]) {
+						t.Fatalf("row %d column %d: got %v; want %v", i, c, row[c], want[c])
+					}
+				}
+			}
+
+			stripeStats, err := f.StripeStatistics()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for column := range f.Footer.GetTypes() {
+				masked := f.Masked(uint32(column))
+				stats := f.Footer.GetStatistics()[column]
+				if got, want := stats, plain.Footer.GetStatistics()[column]; proto.Equal(got, want) == masked {
+					t.Errorf("column %d: got statistics %v, plain %v", column, got, want)
+				}
+				if masked && stats.GetNumberOfValues() != 0 {
+					t.Errorf("column %d: got %d masked values", column, stats.GetNumberOfValues())
+				}
+				for s := range stripeStats {
+					got, want := stripeStats[s].GetColStats()[column], plainStats[s].GetColStats()[column]
+					if proto.Equal(got, want) == masked {
+						t.Errorf("stripe %d column %d: got statistics %v, plain %v", s, column, got, want)
+					}
+				}
+			}
+
+			groups, err := f.RowGroups(1, 8)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(groups) == 0 || !groups[0].HasBloomFilter() {
+				t.Fatalf("got %d row groups of the string column, without bloom filters", len(groups))
+			}
+			// the masked column holds only nulls
+			if groups[0].MightContain("a") == tc.masked[7] {
+				t.Errorf("row group might contain a: got %t", !tc.masked[7])
+			}
+		})
+	}
+}
+
+func TestEncryptionErrors(t *testing.T) {
+	keys := testKeys(t, false)
+	for _, tc := range []struct {
+		name      string
+		encrypted map[uint32]string
+		provider  KeyProvider
+	}{
+		{"no provider", map[uint32]string{8: "pii"}, nil},
+		{"root", map[uint32]string{0: "pii"}, keys},
+		{"not in schema", map[uint32]string{25: "pii"}, keys},
+		{"overlap", map[uint32]string{20: "pii", 21: "credit"}, keys},
+		{"unknown key", map[uint32]string{8: "secret"}, keys},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewWriter(ioutil.Discard, allTypes(), &WriterOptions{
+				EncryptedColumns: tc.encrypted,
+				KeyProvider:      tc.provider,
+			})
+			if err == nil {
+				t.Error("got no error")
+			}
+		})
+	}
+
+	path := writeAllTypes(t, 10, &WriterOptions{EncryptedColumns: map[uint32]string{8: "pii"}, KeyProvider: keys})
+	f, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := Merge(ioutil.Discard, f); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("merge: got %v; want unsupported", err)
+	}
+}
+
+func TestLoadLocalKeyProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys")
+	keys := "# test keys\n\npii 2 AES_CTR_128 AQEBAQEBAQEBAQEBAQEBAQ==\npii 1 AES_CTR_128 AgICAgICAgICAgICAgICAg==\n"
+	if err := ioutil.WriteFile(path, []byte(keys), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := LoadLocalKeyProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := p.CurrentKey("pii")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Version != 2 || current.Algorithm != EncryptionAlgorithm_AES_CTR_128 {
+		t.Errorf("got current key %+v; want version 2", current)
+	}
+	local, encrypted, err := p.CreateLocalKey(current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(local) != 16 || bytes.Equal(local, encrypted) {
+		t.Errorf("got local key %x encrypted as %x", local, encrypted)
+	}
+	if got, err := p.DecryptLocalKey(current, encrypted); err != nil || !bytes.Equal(got, local) {
+		t.Errorf("got decrypted key %x, %v; want %x", got, err, local)
+	}
+	older := KeyMetadata{Name: "pii", Version: 1, Algorithm: EncryptionAlgorithm_AES_CTR_128}
+	if got, err := p.DecryptLocalKey(older, encrypted); err != nil || bytes.Equal(got, local) {
+		t.Errorf("older key decrypted %x, %v", got, err)
+	}
+	if got, err := p.DecryptLocalKey(KeyMetadata{Name: "other"}, encrypted); got != nil || err != nil {
+		t.Errorf("unknown key decrypted %x, %v", got, err)
+	}
+
+	for _, bad := range []string{"pii 1 AES_CTR_128", "pii x AES_CTR_128 AQ==", "pii 1 ROT13 AQ==", "pii 1 AES_CTR_256 AQ=="} {
+		if err := ioutil.WriteFile(path, []byte(bad), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadLocalKeyProvider(path); err == nil {
+			t.Errorf("%q: got no error", bad)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package orc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrCorrupt matches every CorruptFileError with errors.Is
+	ErrCorrupt = errors.New("corrupt ORC file")
+	// ErrUnsupported matches every UnsupportedError with errors.Is
+	ErrUnsupported = errors.New("unsupported ORC feature")
+)
+
+// CorruptFileError reports a section of a file that cannot be decoded, whether truncated, inconsistent or not ORC
+// at all
+type CorruptFileError struct {
+	// Section names the part of the file, such as "postscript", "footer", "metadata", "stripe footer" or "stripe"
+	Section string
+	// Offset is the position of the section in the file
+	Offset int64
+	// Err is the cause
+	Err error
+}
+
+func (e *CorruptFileError) Error() string {
+	return fmt.Sprintf("%s: %s at offset %d: %s", ErrCorrupt, e.Section, e.Offset, e.Err)
+}
+
+func (e *CorruptFileError) Unwrap() error {
+	return e.Err
+}
+
+func (e *CorruptFileError) Is(target error) bool {
+	return target == ErrCorrupt
+}
+
+// UnsupportedError reports a valid file using a feature this package does not implement, such as a compression
+// codec
+type UnsupportedError struct {
+	Feature string
+}
+
+func (e *UnsupportedError) Error() string {
+	return "unsupported " + e.Feature
+}
+
+func (e *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupported
+}
+
+// corrupt reports an error decoding a section of the file as a CorruptFileError, unless it already describes the
+// file or is a cancellation
+func corrupt(section string, offset int64, err error) error {
+	if errors.Is(err, ErrCorrupt) || errors.Is(err, ErrUnsupported) || errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	return &CorruptFileError{Section: section, Offset: offset, Err: err}
+}
@@ -0,0 +1,124 @@
+package orc
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTestFile writes a small file of a single long column
+func writeTestFile(t *testing.T, compression CompressionKind) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []*Type{{Kind: Type_LONG.Enum()}}, &WriterOptions{Compression: compression})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		if err := w.WriteRow([]interface{}{int64(i * 7919)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func openCorrupt(t *testing.T, data []byte) (*File, error) {
+	path := filepath.Join(t.TempDir(), "corrupt.orc")
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := Open(path)
+	if err == nil {
+		t.Cleanup(f.Close)
+	}
+	return f, err
+}
+
+func checkCorrupt(t *testing.T, err error, section string, offset int64) {
+	t.Helper()
+	if !errors.Is(err, ErrCorrupt) || errors.Is(err, ErrUnsupported) {
+		t.Fatalf("got %v; want a corrupt file error", err)
+	}
+	var c *CorruptFileError
+	if !errors.As(err, &c) {
+		t.Fatalf("got %T; want *CorruptFileError", err)
+	}
+	if c.Section != section || (offset >= 0 && c.Offset != offset) {
+		t.Errorf("got corrupt %s at %d; want %s at %d", c.Section, c.Offset, section, offset)
+	}
+}
+
+func TestCorruptFileErrors(t *testing.T) {
+	t.Run("magic", func(t *testing.T) {
+		data := writeTestFile(t, CompressionKind_NONE)
+		// the magic ends the postscript
+		copy(data[len(data)-4:], "XYZ")
+		_, err := openCorrupt(t, data)
+		checkCorrupt(t, err, "postscript", int64(len(data)-1-int(data[len(data)-1])))
+	})
+
+	t.Run("footer", func(t *testing.T) {
+		data := writeTestFile(t, CompressionKind_ZLIB)
+		f, err := openCorrupt(t, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		offset := f.Length() - 1 - f.PostScriptLength() - int64(f.PostScript.GetFooterLength())
+		for i := offset; i < offset+int64(f.PostScript.GetFooterLength()); i++ {
+			data[i] = 0xff
+		}
+		_, err = openCorrupt(t, data)
+		checkCorrupt(t, err, "footer", offset)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		data := writeTestFile(t, CompressionKind_ZLIB)
+		f, err := openCorrupt(t, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info := f.Footer.GetStripes()[0]
+		// the header of the first compression chunk of the data stream claims more than the stream holds
+		offset := info.GetOffset() + info.GetIndexLength()
+		copy(data[offset:], []byte{0xff, 0xff, 0x0f})
+		if f, err = openCorrupt(t, data); err != nil {
+			t.Fatal(err)
+		}
+		_, err = f.ReadStripe(0)
+		checkCorrupt(t, err, "stream", int64(info.GetOffset()+info.GetIndexLength()))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		data := writeTestFile(t, CompressionKind_NONE)
+		f, err := openCorrupt(t, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the stripe footer claims to extend beyond the file
+		info := f.Footer.GetStripes()[0]
+		info.FooterLength = &[]uint64{uint64(f.Length())}[0]
+		_, err = f.ReadStripe(0)
+		checkCorrupt(t, err, "stripe footer", -1)
+	})
+}
+
+func TestUnsupportedErrors(t *testing.T) {
+	_, err := Open("examples/TestVectorOrcFile.testLz4.orc")
+	if !errors.Is(err, ErrUnsupported) || errors.Is(err, ErrCorrupt) {
+		t.Fatalf("got %v; want an unsupported feature error", err)
+	}
+	var u *UnsupportedError
+	if !errors.As(err, &u) || u.Feature != "compression LZ4" {
+		t.Errorf("got %v; want unsupported compression LZ4", err)
+	}
+
+	_, err = Open("examples/missing.orc")
+	if !errors.Is(err, os.ErrNotExist) || errors.Is(err, ErrCorrupt) || errors.Is(err, ErrUnsupported) {
+		t.Errorf("got %v; want a missing file error", err)
+	}
+}
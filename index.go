@@ -0,0 +1,183 @@
+package orc
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// RowGroup is the index entry of a column for a group of rows within a stripe
+type RowGroup struct {
+	// Statistics summarizes the values of the row group, without those its writer is known to have computed wrongly
+	Statistics *ColumnStatistics
+	// Positions locate the start of the row group within the streams of the column
+	Positions []uint64
+
+	t     *Type
+	bloom *bloomFilter
+	// rebase reports the file to be read rebased from the hybrid calendar, its bloom filters hashing the days of that
+	// calendar
+	rebase bool
+}
+
+// HasBloomFilter reports whether the row group has a bloom filter that MightContain consults
+func (g *RowGroup) HasBloomFilter() bool {
+	return g.bloom != nil
+}
+
+// MightContain reports whether the row group might contain v, given as it would be to the Writer. It is false only
+// when the bloom filter of the row group rules v out.
+func (g *RowGroup) MightContain(v interface{}) bool {
+	if g.bloom == nil || v == nil {
+		return true
+	}
+	switch g.t.GetKind() {
+	case Type_BYTE, Type_SHORT, Type_INT, Type_LONG:
+		i, err := toInt64(v, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return true
+		}
+		v = i
+	case Type_FLOAT, Type_DOUBLE:
+		switch f := v.(type) {
+		case float32:
+			v = float64(f)
+		case float64:
+			if g.t.GetKind() == Type_FLOAT {
+				v = float64(float32(f))
+			}
+		}
+	case Type_DATE:
+		if t, ok := v.(time.Time); ok {
+			days := daysSinceEpoch(t)
+			if g.rebase {
+				days = prolepticToHybrid(days)
+			}
+			v = days
+		}
+	case Type_TIMESTAMP:
+		if t, ok := v.(time.Time); ok && g.rebase {
+			v = rebaseTime(t, false)
+		}
+	case Type_DECIMAL:
+		if d, ok := v.(Decimal); ok {
+			v = d.Rescale(int(g.t.GetScale()))
+		}
+	}
+	return g.bloom.test(v)
+}
+
+// RowGroups reads the row index of a column within stripe i, with the bloom filters of the column when it has them.
+// The known bugs of the file's writer are worked around, leaving out statistics and bloom filters it computed wrongly.
+func (f *File) RowGroups(i int, column uint32) ([]*RowGroup, error) {
+	stripes := f.Footer.GetStripes()
+	if i < 0 || i >= len(stripes) {
+		return nil, fmt.Errorf("stripe %d out of range of %d stripes", i, len(stripes))
+	}
+	types := f.Footer.GetTypes()
+	if int(column) >= len(types) {
+		return nil, fmt.Errorf("column %d not in schema of %d columns", column, len(types))
+	}
+	t := types[column]
+	info := stripes[i]
+	footer, err := f.GetStripeFooter(info)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := f.readSection("stripe", int64(info.GetOffset()), int64(info.GetIndexLength()))
+	if err != nil {
+		return nil, err
+	}
+
+	streams, err := f.stripeStreams(i, footer)
+	if err != nil {
+		return nil, err
+	}
+
+	var index RowIndex
+	var filters *BloomFilterIndex
+	var utf8 bool
+	for _, stream := range streams {
+		kind := stream.GetKind()
+		if stream.GetColumn() != column || !isIndexStream(kind) {
+			continue
+		}
+		start, end := stream.offset, stream.offset+stream.GetLength()
+		streamOffset := int64(info.GetOffset() + start)
+		if end > uint64(len(buf)) || end < start {
+			return nil, corrupt("stripe", int64(info.GetOffset()),
+				fmt.Errorf("stream %s of column %d extends past the indexes", kind, column))
+		}
+		data, err := f.decryptStream(i, stream, buf[start:end])
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case kind == Stream_ROW_INDEX:
+			err = f.decodeSection("stream", streamOffset, data, &index)
+		case kind == Stream_BLOOM_FILTER_UTF8 || (!utf8 && f.usableBloomFilter(t)):
+			filters = new(BloomFilterIndex)
+			utf8 = kind == Stream_BLOOM_FILTER_UTF8
+			err = f.decodeSection("stream", streamOffset, data, filters)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	groups := make([]*RowGroup, len(index.GetEntry()))
+	for g, entry := range index.GetEntry() {
+		groups[g] = &RowGroup{
+			Statistics: f.readStatistics(column, entry.GetStatistics()),
+			Positions:  entry.GetPositions(),
+			t:          t,
+			rebase:     f.rebase,
+		}
+	}
+	if filters == nil || f.mask(column) != nil {
+		return groups, nil
+	}
+	if len(filters.GetBloomFilter()) != len(groups) {
+		return nil, corrupt("stripe", int64(info.GetOffset()), fmt.Errorf(
+			"%d bloom filters for %d row groups of column %d", len(filters.GetBloomFilter()), len(groups), column))
+	}
+	loc, err := bloomFilterLocation(footer, column)
+	if err != nil {
+		return nil, err
+	}
+	blooms := make([]*bloomFilter, len(groups))
+	for g, filter := range filters.GetBloomFilter() {
+		if blooms[g], err = loadBloomFilter(filter, loc); err != nil {
+			if !utf8 {
+				// early Hive writers used another message for BLOOM_FILTER streams
+				return groups, nil
+			}
+			return nil, corrupt("stripe", int64(info.GetOffset()), fmt.Errorf("column %d: %w", column, err))
+		}
+	}
+	for g := range groups {
+		groups[g].bloom = blooms[g]
+	}
+	return groups, nil
+}
+
+// usableBloomFilter reports whether the BLOOM_FILTER stream of a column of type t can be trusted, writers before
+// HIVE_12055 hashing strings wrongly
+func (f *File) usableBloomFilter(t *Type) bool {
+	switch t.GetKind() {
+	case Type_STRING, Type_CHAR, Type_VARCHAR:
+		return f.WriterIncludes(HIVE_12055)
+	}
+	return true
+}
+
+// bloomFilterLocation returns the timezone in which the bloom filters of a column hash timestamps, UTC when the
+// column encoding says so and the writer timezone otherwise, as this package and writers before ORC_135 do
+func bloomFilterLocation(footer *StripeFooter, column uint32) (*time.Location, error) {
+	if columns := footer.GetColumns(); int(column) < len(columns) {
+		if columns[column].GetBloomEncoding() == 1 {
+			return time.UTC, nil
+		}
+	}
+	return writerLocation(footer)
+}
@@ -0,0 +1,134 @@
+package orc
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRowGroups(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2, 3, 4, 5, 6, 7},
+			FieldNames: []string{"i", "f", "s", "d", "t", "dec", "plain"}},
+		{Kind: Type_INT.Enum()},
+		{Kind: Type_FLOAT.Enum()},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_DATE.Enum()},
+		{Kind: Type_TIMESTAMP.Enum()},
+		{Kind: Type_DECIMAL.Enum(), Precision: proto.Uint32(10), Scale: proto.Uint32(2)},
+		{Kind: Type_LONG.Enum()},
+	}
+	day := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	row := func(i int) []interface{} {
+		return []interface{}{int32(i), float32(i) / 4, fmt.Sprintf("value %d", i), day.AddDate(0, 0, i),
+			day.Add(time.Duration(i) * time.Second), Decimal{Value: big.NewInt(int64(i)), Scale: 1}, int64(i)}
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, types, &WriterOptions{
+		RowIndexStride:     100,
+		BloomFilterColumns: []uint32{1, 2, 3, 4, 5, 6},
+		Timezone:           time.UTC,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		if err := w.WriteRow(row(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f := openBytes(t, buf.Bytes())
+
+	for column := uint32(1); column < uint32(len(types)); column++ {
+		groups, err := f.RowGroups(0, column)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(groups) != 10 {
+			t.Fatalf("column %d: got %d row groups; want 10", column, len(groups))
+		}
+		var positives int
+		for g, group := range groups {
+			if group.Statistics.GetNumberOfValues() != 100 || len(group.Positions) == 0 {
+				t.Errorf("column %d row group %d: got statistics %v and positions %v", column, g, group.Statistics,
+					group.Positions)
+			}
+			if group.HasBloomFilter() != (column != 7) {
+				t.Errorf("column %d row group %d: got bloom filter %t", column, g, group.HasBloomFilter())
+			}
+			for i := 0; i < 1000; i++ {
+				v := row(i)[column-1]
+				contains := group.MightContain(v)
+				if i/100 == g && !contains {
+					t.Errorf("column %d row group %d: %v ruled out", column, g, v)
+				}
+				if i/100 != g && contains {
+					positives++
+				}
+			}
+		}
+		if column != 7 && positives > 900*10/10 {
+			t.Errorf("column %d: got %d false positives of 9000", column, positives)
+		}
+	}
+
+	// the timestamp statistics written by this package are in UTC, as since ORC-135
+	groups, err := f.RowGroups(0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts := groups[0].Statistics.GetTimestampStatistics(); ts.GetMinimumUtc() != day.UnixMilli() {
+		t.Errorf("got timestamp statistics %v", ts)
+	}
+
+	if _, err := f.RowGroups(1, 1); err == nil {
+		t.Error("read the row groups of a missing stripe")
+	}
+	if _, err := f.RowGroups(0, 8); err == nil {
+		t.Error("read the row groups of a missing column")
+	}
+}
+
+func TestRowGroupsJava(t *testing.T) {
+	// written by HIVE-8732, before timestamp statistics were in UTC, with bloom filters predating the BloomFilter
+	// message
+	f, err := Open("examples/over1k_bloom.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	batch, err := f.ReadStripe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for column := uint32(1); column <= 11; column++ {
+		groups, err := f.RowGroups(0, column)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(groups) != 1 {
+			t.Fatalf("column %d: got %d row groups; want 1", column, len(groups))
+		}
+		group := groups[0]
+		if group.HasBloomFilter() {
+			t.Errorf("column %d: got a bloom filter", column)
+		}
+		if kind := f.Footer.GetTypes()[column].GetKind(); kind == Type_TIMESTAMP &&
+			group.Statistics.TimestampStatistics != nil {
+			t.Errorf("column %d: got statistics %v", column, group.Statistics)
+		}
+		for i, v := range batch.Columns[column-1] {
+			if !group.MightContain(v) {
+				t.Fatalf("column %d: %v of row %d ruled out", column, v, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Package jsonl writes the rows of ORC files as JSON Lines for the commands, as the Java tools' `orc-tools data`
+// does.
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/graphaelli/gorc"
+)
+
+// TimeLayout is the layout timestamps are written in, the wall clock time of the writer
+const TimeLayout = "2006-01-02 15:04:05.999999999"
+
+// WriteRow writes a row of a file of the given types as a line, an object of the fields of the root struct or the
+// value of a root of another kind
+func WriteRow(w *bufio.Writer, types []*orc.Type, row []interface{}) error {
+	var err error
+	if types[0].GetKind() != orc.Type_STRUCT {
+		err = WriteValue(w, types, 0, row[0])
+	} else {
+		err = WriteValue(w, types, 0, row)
+	}
+	if err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
+
+// WriteJSON writes v as JSON, without escaping HTML or ending it with a newline
+func WriteJSON(w io.Writer, v interface{}) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := w.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	return err
+}
+
+// WriteValue writes the value v of column id as the Java tools do: structs as objects, maps as lists of objects of
+// _key and _value, unions as objects of tag and value, binary as a list of bytes, dates and timestamps as strings,
+// and decimals as numbers. Floating point numbers JSON cannot hold are written as strings.
+func WriteValue(w *bufio.Writer, types []*orc.Type, id uint32, v interface{}) error {
+	if v == nil {
+		_, err := w.WriteString("null")
+		return err
+	}
+	t := types[id]
+	switch v := v.(type) {
+	case []interface{}:
+		subtypes := t.GetSubtypes()
+		if t.GetKind() == orc.Type_STRUCT {
+			w.WriteByte('{')
+			for i, field := range v {
+				if i > 0 {
+					w.WriteByte(',')
+				}
+				if err := WriteJSON(w, t.GetFieldNames()[i]); err != nil {
+					return err
+				}
+				w.WriteByte(':')
+				if err := WriteValue(w, types, subtypes[i], field); err != nil {
+					return err
+				}
+			}
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			if err := WriteValue(w, types, subtypes[0], elem); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+		return nil
+	case []orc.MapEntry:
+		subtypes := t.GetSubtypes()
+		w.WriteByte('[')
+		for i, entry := range v {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(`{"_key":`)
+			if err := WriteValue(w, types, subtypes[0], entry.Key); err != nil {
+				return err
+			}
+			w.WriteString(`,"_value":`)
+			if err := WriteValue(w, types, subtypes[1], entry.Value); err != nil {
+				return err
+			}
+			w.WriteByte('}')
+		}
+		w.WriteByte(']')
+		return nil
+	case orc.Union:
+		fmt.Fprintf(w, `{"tag":%d,"value":`, v.Tag)
+		if err := WriteValue(w, types, t.GetSubtypes()[v.Tag], v.Value); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case []byte:
+		w.WriteByte('[')
+		for i, b := range v {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(strconv.Itoa(int(b)))
+		}
+		w.WriteByte(']')
+		return nil
+	case time.Time:
+		if t.GetKind() == orc.Type_DATE {
+			return WriteJSON(w, v.Format("2006-01-02"))
+		}
+		return WriteJSON(w, v.Format(TimeLayout))
+	case orc.Decimal:
+		_, err := w.WriteString(v.String())
+		return err
+	case float32:
+		return writeFloat(w, float64(v), 32)
+	case float64:
+		return writeFloat(w, v, 64)
+	}
+	return WriteJSON(w, v)
+}
+
+func writeFloat(w *bufio.Writer, f float64, bits int) error {
+	s := strconv.FormatFloat(f, 'g', -1, bits)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		s = strconv.Quote(s)
+	}
+	_, err := w.WriteString(s)
+	return err
+}
@@ -0,0 +1,39 @@
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/graphaelli/gorc"
+)
+
+func TestWriteRow(t *testing.T) {
+	kind := func(k orc.Type_Kind, subtypes ...uint32) *orc.Type {
+		return &orc.Type{Kind: k.Enum(), Subtypes: subtypes}
+	}
+	root := kind(orc.Type_STRUCT, 1, 2, 3, 6, 7)
+	root.FieldNames = []string{"d", "ts", "u", "f", "b"}
+	types := []*orc.Type{root, kind(orc.Type_DATE), kind(orc.Type_TIMESTAMP), kind(orc.Type_UNION, 4, 5),
+		kind(orc.Type_INT), kind(orc.Type_STRING), kind(orc.Type_DOUBLE), kind(orc.Type_BINARY)}
+	day := time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	for _, row := range [][]interface{}{
+		{day, day.Add(1500 * time.Millisecond), orc.Union{Tag: 1, Value: "<a>"}, math.Inf(-1), []byte{1, 255}},
+		{nil, nil, orc.Union{Tag: 0, Value: int32(7)}, 0.5, nil},
+	} {
+		if err := WriteRow(w, types, row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Flush()
+	want := `{"d":"2020-02-29","ts":"2020-02-29 00:00:01.5","u":{"tag":1,"value":"<a>"},"f":"-Inf","b":[1,255]}` + "\n" +
+		`{"d":null,"ts":null,"u":{"tag":0,"value":7},"f":0.5,"b":null}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
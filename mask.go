@@ -0,0 +1,213 @@
+package orc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MaskKind selects how a ColumnMask replaces the values of a column
+type MaskKind int
+
+const (
+	// MASK_NULLIFY replaces every value by null
+	MASK_NULLIFY MaskKind = iota
+	// MASK_REDACT replaces strings and binary values by "xxx"
+	MASK_REDACT
+	// MASK_SHA256 replaces strings by the hex encoded SHA-256 hash of their bytes, truncated to the length of char
+	// and varchar columns, and binary values by the hash itself
+	MASK_SHA256
+	// MASK_KEEP_LAST replaces all but the last ColumnMask.Keep characters of strings, or bytes of binary values, by x
+	MASK_KEEP_LAST
+)
+
+var maskKinds = map[MaskKind]string{
+	MASK_NULLIFY:   "nullify",
+	MASK_REDACT:    "redact",
+	MASK_SHA256:    "sha256",
+	MASK_KEEP_LAST: "keep-last",
+}
+
+func (k MaskKind) String() string {
+	if s, ok := maskKinds[k]; ok {
+		return s
+	}
+	return fmt.Sprintf("unknown mask %d", int(k))
+}
+
+// ColumnMask masks the values of a column and of the columns under it before they reach the caller, hiding them
+// from statistics, row indexes and bloom filters as well. Masks other than MASK_NULLIFY keep nulls, and replace by
+// null the values of kinds other than strings and binary.
+type ColumnMask struct {
+	// Path names the column as File.FindColumn does
+	Path string
+	Kind MaskKind
+	// Keep is the number of trailing characters MASK_KEEP_LAST leaves unmasked
+	Keep int
+}
+
+// ParseColumnMask parses a mask given as path=kind, such as "customer.email=sha256", the kind being nullify, redact,
+// sha256 or keep-last-N
+func ParseColumnMask(s string) (ColumnMask, error) {
+	i := strings.LastIndex(s, "=")
+	if i <= 0 {
+		return ColumnMask{}, fmt.Errorf("mask %q is not path=kind", s)
+	}
+	m := ColumnMask{Path: s[:i]}
+	kind := s[i+1:]
+	for k, name := range maskKinds {
+		if kind == name {
+			m.Kind = k
+			return m, nil
+		}
+	}
+	if n := strings.TrimPrefix(kind, "keep-last-"); n != kind {
+		keep, err := strconv.Atoi(n)
+		if err != nil || keep < 0 {
+			return ColumnMask{}, fmt.Errorf("mask %q keeps %q characters", s, n)
+		}
+		m.Kind, m.Keep = MASK_KEEP_LAST, keep
+		return m, nil
+	}
+	return ColumnMask{}, fmt.Errorf("mask %q of unknown kind %q", s, kind)
+}
+
+// setMasks finds the columns of the masks, later masks replacing earlier ones over the columns they share
+func (f *File) setMasks(masks []ColumnMask) error {
+	if len(masks) == 0 {
+		return nil
+	}
+	types := f.Footer.GetTypes()
+	f.masks = make([]*ColumnMask, len(types))
+	for i := range masks {
+		m := &masks[i]
+		switch m.Kind {
+		case MASK_NULLIFY, MASK_REDACT, MASK_SHA256, MASK_KEEP_LAST:
+		default:
+			return fmt.Errorf("mask of %s: %s", m.Path, m.Kind)
+		}
+		root, err := f.FindColumn(m.Path)
+		if err != nil {
+			return err
+		}
+		for id := root; id < subtreeEnd(types, root); id++ {
+			f.masks[id] = m
+		}
+	}
+	return nil
+}
+
+// mask returns the mask of a column, nil when it is not masked
+func (f *File) mask(column uint32) *ColumnMask {
+	if int(column) >= len(f.masks) {
+		return nil
+	}
+	return f.masks[column]
+}
+
+// maskStatistics returns the statistics of a column without anything revealing its values when it is masked
+func (f *File) maskStatistics(column uint32, s *ColumnStatistics) *ColumnStatistics {
+	m := f.mask(column)
+	if m == nil || s == nil {
+		return s
+	}
+	masked := &ColumnStatistics{NumberOfValues: s.NumberOfValues, HasNull: s.HasNull, BytesOnDisk: s.BytesOnDisk}
+	if types := f.Footer.GetTypes(); int(column) >= len(types) || !m.keeps(types[column]) {
+		masked.NumberOfValues = proto.Uint64(0)
+		masked.HasNull = proto.Bool(s.GetHasNull() || s.GetNumberOfValues() > 0)
+	}
+	return masked
+}
+
+// keeps reports whether the mask replaces the values of columns of type t by others rather than by null
+func (m *ColumnMask) keeps(t *Type) bool {
+	if m.Kind == MASK_NULLIFY {
+		return false
+	}
+	switch t.GetKind() {
+	case Type_STRING, Type_CHAR, Type_VARCHAR, Type_BINARY:
+		return true
+	}
+	return false
+}
+
+// apply masks a value of a column of type t
+func (m *ColumnMask) apply(t *Type, v interface{}) interface{} {
+	if v == nil || m.Kind == MASK_NULLIFY {
+		return nil
+	}
+	switch v := v.(type) {
+	case string:
+		switch m.Kind {
+		case MASK_REDACT:
+			return "xxx"
+		case MASK_SHA256:
+			sum := sha256.Sum256([]byte(v))
+			hash := hex.EncodeToString(sum[:])
+			if n := int(t.GetMaximumLength()); n > 0 && n < len(hash) &&
+				(t.GetKind() == Type_CHAR || t.GetKind() == Type_VARCHAR) {
+				hash = hash[:n]
+			}
+			return hash
+		case MASK_KEEP_LAST:
+			masked := utf8.RuneCountInString(v) - m.Keep
+			var b strings.Builder
+			for i, r := range []rune(v) {
+				if i < masked {
+					r = 'x'
+				}
+				b.WriteRune(r)
+			}
+			return b.String()
+		}
+	case []byte:
+		switch m.Kind {
+		case MASK_REDACT:
+			return []byte("xxx")
+		case MASK_SHA256:
+			sum := sha256.Sum256(v)
+			return sum[:]
+		case MASK_KEEP_LAST:
+			masked := make([]byte, len(v))
+			for i := range v {
+				if i < len(v)-m.Keep {
+					masked[i] = 'x'
+				} else {
+					masked[i] = v[i]
+				}
+			}
+			return masked
+		}
+	}
+	return nil
+}
+
+// nullColumn reads a nullified column, whose streams are not decoded at all
+type nullColumn struct{}
+
+func (nullColumn) next(n int) ([]interface{}, error) {
+	return make([]interface{}, n), nil
+}
+
+// maskedColumn masks the values decoded from a column
+type maskedColumn struct {
+	columnReader
+	t    *Type
+	mask *ColumnMask
+}
+
+func (c *maskedColumn) next(n int) ([]interface{}, error) {
+	values, err := c.columnReader.next(n)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range values {
+		values[i] = c.mask.apply(c.t, v)
+	}
+	return values, nil
+}
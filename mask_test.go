@@ -0,0 +1,191 @@
+package orc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFindColumn(t *testing.T) {
+	f, err := Open(writeAllTypes(t, 1, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	for path, want := range map[string]uint32{
+		"boolean":      1,
+		"string":       8,
+		"list._elem":   16,
+		"map._key":     18,
+		"map._value":   19,
+		"struct":       20,
+		"struct.inner": 21,
+		"union.1":      24,
+	} {
+		if got, err := f.FindColumn(path); err != nil || got != want {
+			t.Errorf("%s: got column %d, %v; want %d", path, got, err, want)
+		}
+	}
+	paths := f.ColumnPaths()
+	if len(paths) != len(f.Footer.GetTypes()) || paths[0] != "" {
+		t.Fatalf("got paths %q", paths)
+	}
+	for id, path := range paths[1:] {
+		if got, err := f.FindColumn(path); err != nil || got != uint32(id+1) {
+			t.Errorf("%s: got column %d, %v; want %d", path, got, err, id+1)
+		}
+	}
+	for _, path := range []string{"", "nope", "list.x", "map._elem", "union.2", "boolean.x", "struct..inner"} {
+		if got, err := f.FindColumn(path); err == nil {
+			t.Errorf("%q: got column %d; want an error", path, got)
+		}
+	}
+}
+
+func TestParseColumnMask(t *testing.T) {
+	for s, want := range map[string]ColumnMask{
+		"a=nullify":               {Path: "a", Kind: MASK_NULLIFY},
+		"a.b=redact":              {Path: "a.b", Kind: MASK_REDACT},
+		"a=sha256":                {Path: "a", Kind: MASK_SHA256},
+		"card.number=keep-last-4": {Path: "card.number", Kind: MASK_KEEP_LAST, Keep: 4},
+	} {
+		if got, err := ParseColumnMask(s); err != nil || got != want {
+			t.Errorf("%s: got %+v, %v; want %+v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"a", "=redact", "a=hide", "a=keep-last-x", "a=keep-last--1"} {
+		if got, err := ParseColumnMask(s); err == nil {
+			t.Errorf("%s: got %+v; want an error", s, got)
+		}
+	}
+}
+
+func TestMasks(t *testing.T) {
+	const n = 3000
+	path := writeAllTypes(t, n, &WriterOptions{
+		Compression:        CompressionKind_ZLIB,
+		StripeSize:         16 * 1024,
+		RowIndexStride:     1000,
+		BloomFilterColumns: []uint32{8, 9},
+	})
+	plain, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plain.Close()
+	var masks []ColumnMask
+	for _, s := range []string{"string=redact", "varchar=sha256", "char=keep-last-1", "binary=keep-last-1",
+		"struct=nullify", "map._value=redact", "timestamp=sha256"} {
+		m, err := ParseColumnMask(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		masks = append(masks, m)
+	}
+	f, err := OpenWithOptions(path, &OpenOptions{Masks: masks})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	sum := sha256.Sum256([]byte("varchar"))
+	for i, row := range readAll(t, f) {
+		want := allTypesRow(i)
+		if want[7] != nil {
+			want[7] = "xxx"
+			want[8] = hex.EncodeToString(sum[:])[:10]
+			want[9] = "xxr"
+			b := want[10].([]byte)
+			want[10] = []byte{'x', b[1]}
+			want[11] = nil
+			want[15] = []MapEntry{{Key: "key"}}
+			want[16] = nil
+		}
+		for c := range want {
+			if !sameValue(row[c], want[c]) {
+				t.Fatalf("row %d column %d: got %v; want %v", i, c, row[c], want[c])
+			}
+		}
+	}
+
+	stripes, err := f.StripeStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, stats := range f.Statistics() {
+		column := uint32(id)
+		masked := f.mask(column) != nil
+		if !masked {
+			if want := plain.Footer.GetStatistics()[id]; stats.String() != want.String() {
+				t.Errorf("column %d: got statistics %v; want %v", id, stats, want)
+			}
+			continue
+		}
+		for _, s := range append([]*ColumnStatistics{stats}, stripes[0].GetColStats()[id]) {
+			if s.GetStringStatistics() != nil || s.GetBinaryStatistics() != nil || s.GetTimestampStatistics() != nil ||
+				s.GetDoubleStatistics() != nil || s.GetIntStatistics() != nil {
+				t.Errorf("column %d: got masked statistics %v", id, s)
+			}
+		}
+		// masks other than nullify turn the timestamps and the doubles of the map to null as well
+		nullified := column == 12 || column == 19 || column == 20 || column == 21
+		if nullified != (stats.GetNumberOfValues() == 0) || nullified && !stats.GetHasNull() {
+			t.Errorf("column %d: got %d values, has null %t", id, stats.GetNumberOfValues(), stats.GetHasNull())
+		}
+	}
+
+	// the statistics as stored are left alone, for Merge among others
+	stored, err := f.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	plainStored, err := plain.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(&f.Footer, &plain.Footer) || !proto.Equal(stored, plainStored) {
+		t.Error("masking changed the statistics stored in the footer or metadata")
+	}
+
+	for _, column := range []uint32{8, 9} {
+		groups, err := f.RowGroups(0, column)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(groups) == 0 || groups[0].HasBloomFilter() || groups[0].Statistics.GetStringStatistics() != nil {
+			t.Errorf("column %d: got %d row groups, the first with statistics %v", column, len(groups),
+				groups[0].Statistics)
+		}
+	}
+	if groups, err := plain.RowGroups(0, 8); err != nil || !groups[0].HasBloomFilter() {
+		t.Errorf("unmasked column: got %v; want bloom filters", err)
+	}
+
+	if _, err := OpenWithOptions(path, &OpenOptions{Masks: []ColumnMask{{Path: "missing"}}}); err == nil {
+		t.Error("got no error for a mask of a missing column")
+	}
+}
+
+func TestMaskApply(t *testing.T) {
+	str := &Type{Kind: Type_STRING.Enum()}
+	keep := &ColumnMask{Kind: MASK_KEEP_LAST, Keep: 4}
+	for _, tc := range []struct {
+		mask *ColumnMask
+		v    interface{}
+		want interface{}
+	}{
+		{keep, "4111 1111 1111 1234", "xxxxxxxxxxxxxxx1234"},
+		{keep, "héllo wörld", "xxxxxxxörld"},
+		{keep, "abc", "abc"},
+		{keep, int64(12345), nil},
+		{keep, nil, nil},
+		{&ColumnMask{Kind: MASK_REDACT}, "secret", "xxx"},
+		{&ColumnMask{Kind: MASK_NULLIFY}, "secret", nil},
+	} {
+		if got := tc.mask.apply(str, tc.v); got != tc.want {
+			t.Errorf("%s of %v: got %v; want %v", tc.mask.Kind, tc.v, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package orc
+
+import (
+	"sync"
+)
+
+// DEFAULT_ROWS_BETWEEN_MEMORY_CHECKS is the number of rows each writer writes between reports to its MemoryManager
+const DEFAULT_ROWS_BETWEEN_MEMORY_CHECKS = 5000
+
+// MemoryManager bounds the bytes buffered by the open writers sharing it, as the Java writer's MemoryManager does.
+// When the stripe sizes of the writers add up to more than the budget each is scaled down in proportion, and when
+// the bytes they report buffering exceed it the writers holding more than their share flush their stripes early.
+// A MemoryManager is safe for concurrent use by writers in different goroutines.
+type MemoryManager struct {
+	budget    int64
+	checkRows int
+
+	mu sync.Mutex
+	// allocated is the sum of the stripe sizes of the writers, buffered the sum of the bytes they last reported
+	allocated int64
+	buffered  int64
+	writers   map[*Writer]*writerMemory
+}
+
+type writerMemory struct {
+	stripeSize int64
+	buffered   int64
+}
+
+// NewMemoryManager returns a MemoryManager keeping the writers sharing it within budget bytes
+func NewMemoryManager(budget int64) *MemoryManager {
+	return &MemoryManager{
+		budget:    budget,
+		checkRows: DEFAULT_ROWS_BETWEEN_MEMORY_CHECKS,
+		writers:   make(map[*Writer]*writerMemory),
+	}
+}
+
+// Buffered returns the bytes the writers reported buffering at their last check
+func (m *MemoryManager) Buffered() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.buffered
+}
+
+func (m *MemoryManager) add(w *Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.writers[w] = &writerMemory{stripeSize: w.opts.StripeSize}
+	m.allocated += w.opts.StripeSize
+}
+
+func (m *MemoryManager) remove(w *Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if mem, ok := m.writers[w]; ok {
+		m.allocated -= mem.stripeSize
+		m.buffered -= mem.buffered
+		delete(m.writers, w)
+	}
+}
+
+// check records the bytes w buffers, returning the stripe size w is to flush at and whether it is to flush now to
+// bring the writers back within budget
+func (m *MemoryManager) check(w *Writer, buffered int64) (stripeSize int64, flush bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	mem := m.writers[w]
+	m.buffered += buffered - mem.buffered
+	mem.buffered = buffered
+	stripeSize = mem.stripeSize
+	if m.allocated > m.budget {
+		stripeSize = int64(float64(stripeSize) * float64(m.budget) / float64(m.allocated))
+	}
+	flush = m.buffered > m.budget && buffered*int64(len(m.writers)) >= m.buffered
+	return stripeSize, flush
+}
+
+// checkMemory reports the bytes buffered to the memory manager every checkRows rows, adjusting the stripe size,
+// and reports whether the stripe is to be flushed early
+func (w *Writer) checkMemory() bool {
+	if w.memory == nil {
+		return false
+	}
+	if w.memoryRows++; w.memoryRows < w.memory.checkRows {
+		return false
+	}
+	w.memoryRows = 0
+	stripeSize, flush := w.memory.check(w, w.root.size())
+	w.stripeSize = stripeSize
+	return flush
+}
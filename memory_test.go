@@ -0,0 +1,117 @@
+package orc
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"testing"
+)
+
+func TestMemoryManagerScale(t *testing.T) {
+	m := NewMemoryManager(3 << 20)
+	types := []*Type{{Kind: Type_LONG.Enum()}}
+	var writers []*Writer
+	for i := 0; i < 3; i++ {
+		w, err := NewWriter(ioutil.Discard, types, &WriterOptions{StripeSize: 2 << 20, MemoryManager: m})
+		if err != nil {
+			t.Fatal(err)
+		}
+		writers = append(writers, w)
+	}
+	if size, _ := m.check(writers[0], 0); size != 1<<20 {
+		t.Errorf("got stripe size %d; want %d", size, 1<<20)
+	}
+	writers[1].Close()
+	if size, _ := m.check(writers[0], 0); size != 3<<19 {
+		t.Errorf("got stripe size %d; want %d", size, 3<<19)
+	}
+	writers[2].Close()
+	if size, _ := m.check(writers[0], 0); size != 2<<20 {
+		t.Errorf("got stripe size %d; want %d", size, 2<<20)
+	}
+	writers[0].Close()
+	if len(m.writers) != 0 || m.allocated != 0 {
+		t.Errorf("got %d writers allocating %d bytes after closing them all", len(m.writers), m.allocated)
+	}
+}
+
+func TestMemoryManagerFlush(t *testing.T) {
+	m := NewMemoryManager(1000)
+	types := []*Type{{Kind: Type_LONG.Enum()}}
+	a, err := NewWriter(ioutil.Discard, types, &WriterOptions{StripeSize: 500, MemoryManager: m})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewWriter(ioutil.Discard, types, &WriterOptions{StripeSize: 500, MemoryManager: m})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		w        *Writer
+		buffered int64
+		flush    bool
+	}{
+		{a, 600, false},
+		// over budget, but b holds less than its share
+		{b, 450, false},
+		{a, 700, true},
+		{a, 0, false},
+		{b, 480, false},
+	} {
+		if _, flush := m.check(test.w, test.buffered); flush != test.flush {
+			t.Errorf("%d bytes buffered: got flush %t; want %t", test.buffered, flush, test.flush)
+		}
+	}
+	if m.Buffered() != 480 {
+		t.Errorf("got %d bytes buffered; want 480", m.Buffered())
+	}
+}
+
+func TestMemoryManagerWriters(t *testing.T) {
+	const budget = 64 << 10
+	m := NewMemoryManager(budget)
+	m.checkRows = 100
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2}, FieldNames: []string{"i", "s"}},
+		{Kind: Type_LONG.Enum()},
+		{Kind: Type_STRING.Enum()},
+	}
+	bufs := make([]bytes.Buffer, 8)
+	writers := make([]*Writer, len(bufs))
+	for i := range writers {
+		var err error
+		writers[i], err = NewWriter(&bufs[i], types, &WriterOptions{Compression: CompressionKind_NONE, MemoryManager: m})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	const n = 5000
+	for i := 0; i < n; i++ {
+		for j, w := range writers {
+			if err := w.WriteRow([]interface{}{int64(i), fmt.Sprintf("writer %d row %d", j, i)}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// the writers are over budget by at most what they buffer between checks
+		if buffered := m.Buffered(); buffered > budget+int64(len(writers))*8<<10 {
+			t.Fatalf("row %d: writers buffer %d bytes", i, buffered)
+		}
+	}
+	for _, w := range writers {
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if m.Buffered() != 0 || len(m.writers) != 0 {
+		t.Errorf("got %d bytes buffered by %d writers after closing them all", m.Buffered(), len(m.writers))
+	}
+	for i := range bufs {
+		f := openBytes(t, bufs[i].Bytes())
+		if f.Footer.GetNumberOfRows() != n {
+			t.Errorf("writer %d: got %d rows; want %d", i, f.Footer.GetNumberOfRows(), n)
+		}
+		if len(f.Footer.GetStripes()) < 2 {
+			t.Errorf("writer %d: got %d stripes; want several", i, len(f.Footer.GetStripes()))
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package orc
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Merge writes to dst a file holding the stripes of srcs in order, copied without decoding them. The files must
+// share their schema, compression, compression block size, row index stride, calendar, file version and writer
+// version, which the merged file keeps. Statistics and user metadata are merged, later values of a name replacing
+// earlier ones. Stripes and statistics are copied as stored, whatever the sources were opened with.
+func Merge(dst io.Writer, srcs ...*File) error {
+	if len(srcs) == 0 {
+		return fmt.Errorf("no files to merge")
+	}
+	first := srcs[0]
+	for i, f := range srcs {
+		if err := checkMergeable(first, f); err != nil {
+			return fmt.Errorf("file %d: %w", i, err)
+		}
+	}
+
+	stride := int(first.Footer.GetRowIndexStride())
+	if stride == 0 {
+		stride = -1
+	}
+	types := first.Footer.GetTypes()
+	w, err := NewWriter(dst, types, &WriterOptions{
+		Compression:          first.PostScript.GetCompression(),
+		CompressionBlockSize: int(first.PostScript.GetCompressionBlockSize()),
+		RowIndexStride:       stride,
+	})
+	if err != nil {
+		return err
+	}
+	w.fileVersion = first.PostScript.GetVersion()
+	w.writerVersion = first.PostScript.GetWriterVersion()
+	w.calendar = first.Footer.GetCalendar()
+
+	for i, f := range srcs {
+		stripeStats, err := f.storedStripeStatistics()
+		if err != nil {
+			return fmt.Errorf("file %d: %w", i, err)
+		}
+		for s, info := range f.Footer.GetStripes() {
+			if err := w.copyStripe(f, info, stripeStats[s]); err != nil {
+				return fmt.Errorf("file %d stripe %d: %w", i, s, err)
+			}
+		}
+		for id, stats := range f.Footer.GetStatistics() {
+			w.fileStats[id].merge(loadStatistics(types[id], stats))
+		}
+		for _, item := range f.Footer.GetMetadata() {
+			w.AddUserMetadata(item.GetName(), item.GetValue())
+		}
+	}
+	w.closed = true
+	return w.writeTail()
+}
+
+// checkMergeable reports why the stripes of f cannot be copied into a file described by the tail of first
+func checkMergeable(first, f *File) error {
+	if f.Footer.GetEncryption() != nil {
+		// the keys of each file encrypt its own stripes
+		return &UnsupportedError{Feature: "merging encrypted files"}
+	}
+	types, want := f.Footer.GetTypes(), first.Footer.GetTypes()
+	if len(types) != len(want) {
+		return fmt.Errorf("schema has %d types, expected %d", len(types), len(want))
+	}
+	for id := range types {
+		if !proto.Equal(types[id], want[id]) {
+			return fmt.Errorf("type %d is %s, expected %s", id, types[id], want[id])
+		}
+	}
+	if len(f.Footer.GetStatistics()) != len(types) {
+		return fmt.Errorf("statistics for %d of %d columns", len(f.Footer.GetStatistics()), len(types))
+	}
+	if _, err := f.storedStripeStatistics(); err != nil {
+		return err
+	}
+
+	ps, wantPS := &f.PostScript, &first.PostScript
+	if ps.GetCompression() != wantPS.GetCompression() {
+		return fmt.Errorf("compression %s, expected %s", ps.GetCompression(), wantPS.GetCompression())
+	}
+	if ps.GetCompressionBlockSize() != wantPS.GetCompressionBlockSize() {
+		return fmt.Errorf("compression block size %d, expected %d", ps.GetCompressionBlockSize(),
+			wantPS.GetCompressionBlockSize())
+	}
+	if f.Footer.GetRowIndexStride() != first.Footer.GetRowIndexStride() {
+		return fmt.Errorf("row index stride %d, expected %d", f.Footer.GetRowIndexStride(),
+			first.Footer.GetRowIndexStride())
+	}
+	if !reflect.DeepEqual(ps.GetVersion(), wantPS.GetVersion()) {
+		return fmt.Errorf("file version %v, expected %v", ps.GetVersion(), wantPS.GetVersion())
+	}
+	if f.Calendar() != first.Calendar() {
+		return fmt.Errorf("calendar %s, expected %s", f.Calendar(), first.Calendar())
+	}
+	if ps.GetWriterVersion() != wantPS.GetWriterVersion() {
+		return fmt.Errorf("writer version %d, expected %d", ps.GetWriterVersion(), wantPS.GetWriterVersion())
+	}
+	return nil
+}
+
+// copyStripe appends a stripe of f, unchanged but for its offset
+func (w *Writer) copyStripe(f *File, info *StripeInformation, stats *StripeStatistics) error {
+	length := info.GetIndexLength() + info.GetDataLength() + info.GetFooterLength()
+	offset := w.offset
+	n, err := io.Copy(w.w, io.NewSectionReader(f.r, int64(info.GetOffset()), int64(length)))
+	w.offset += uint64(n)
+	if err == nil && uint64(n) != length {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		w.err = err
+		return err
+	}
+	copied := proto.Clone(info).(*StripeInformation)
+	copied.Offset = &offset
+	w.stripes = append(w.stripes, copied)
+	w.stripeStats = append(w.stripeStats, stats)
+	w.numRows += info.GetNumberOfRows()
+	return nil
+}
@@ -0,0 +1,219 @@
+package orc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// readAll returns every row of f
+func readAll(t *testing.T, f *File) [][]interface{} {
+	var all [][]interface{}
+	rows := f.Rows(context.Background(), nil)
+	defer rows.Close()
+	for rows.Next() {
+		all = append(all, rows.Row())
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return all
+}
+
+func TestMerge(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2, 3}, FieldNames: []string{"i", "s", "d"}},
+		{Kind: Type_LONG.Enum()},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_DECIMAL.Enum(), Precision: proto.Uint32(10), Scale: proto.Uint32(2)},
+	}
+	row := func(i int) []interface{} {
+		var s interface{}
+		if i%5 != 0 {
+			s = fmt.Sprintf("value %d", i%100)
+		}
+		return []interface{}{int64(i - 1000), s, Decimal{Value: big.NewInt(int64(i * 3)), Scale: 2}}
+	}
+	opts := &WriterOptions{Compression: CompressionKind_ZLIB, StripeSize: 4 * 1024, RowIndexStride: 100}
+	write := func(from, to int, metadata ...string) *File {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, types, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < len(metadata); i += 2 {
+			w.AddUserMetadata(metadata[i], []byte(metadata[i+1]))
+		}
+		for i := from; i < to; i++ {
+			if err := w.WriteRow(row(i)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return openBytes(t, buf.Bytes())
+	}
+	srcs := []*File{
+		write(0, 1500, "source", "a", "first", "yes"),
+		write(1500, 1501),
+		write(1501, 4000, "source", "c"),
+	}
+
+	var buf bytes.Buffer
+	if err := Merge(&buf, srcs...); err != nil {
+		t.Fatal(err)
+	}
+	merged := openBytes(t, buf.Bytes())
+	whole := write(0, 4000)
+
+	if got, want := merged.Footer.GetNumberOfRows(), uint64(4000); got != want {
+		t.Errorf("got %d rows; want %d", got, want)
+	}
+	var stripes int
+	for _, f := range srcs {
+		stripes += len(f.Footer.GetStripes())
+	}
+	if got := len(merged.Footer.GetStripes()); got != stripes || stripes < 4 {
+		t.Errorf("got %d stripes; want %d", got, stripes)
+	}
+	if stats, err := merged.StripeStatistics(); err != nil || len(stats) != stripes {
+		t.Errorf("got statistics of %d stripes, %v; want %d", len(stats), err, stripes)
+	}
+	for id, want := range whole.Footer.GetStatistics() {
+		if got := merged.Footer.GetStatistics()[id]; !proto.Equal(got, want) {
+			t.Errorf("column %d: got statistics %v; want %v", id, got, want)
+		}
+	}
+	if merged.Footer.GetRowIndexStride() != 100 {
+		t.Errorf("got row index stride %d; want 100", merged.Footer.GetRowIndexStride())
+	}
+	metadata := map[string]string{}
+	for _, item := range merged.Footer.GetMetadata() {
+		metadata[item.GetName()] = string(item.GetValue())
+	}
+	if want := map[string]string{"source": "c", "first": "yes"}; !reflect.DeepEqual(metadata, want) {
+		t.Errorf("got user metadata %v; want %v", metadata, want)
+	}
+	if got, want := readAll(t, merged), readAll(t, whole); !reflect.DeepEqual(got, want) {
+		t.Error("merged rows differ from those written")
+	}
+}
+
+func TestMergeRebasedMasked(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2}, FieldNames: []string{"date", "name"}},
+		{Kind: Type_DATE.Enum()},
+		{Kind: Type_STRING.Enum()},
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, types, &WriterOptions{HybridCalendar: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, d := range []time.Time{date(1000, time.January, 1), date(1582, time.October, 4), date(2000, time.March, 1)} {
+		if err := w.WriteRow([]interface{}{d, fmt.Sprintf("name %d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	src, err := OpenWithOptions(openBytes(t, buf.Bytes()).r.Name(), &OpenOptions{
+		RebaseCalendar: true,
+		Masks:          []ColumnMask{{Path: "name", Kind: MASK_REDACT}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+	if proto.Equal(src.Statistics()[1], src.Footer.GetStatistics()[1]) {
+		t.Fatal("rebased date statistics equal those stored")
+	}
+
+	var out bytes.Buffer
+	if err := Merge(&out, src); err != nil {
+		t.Fatal(err)
+	}
+	merged := openBytes(t, out.Bytes())
+	// the stripes are copied as stored, so must their statistics be
+	for id, want := range src.Footer.GetStatistics() {
+		if got := merged.Footer.GetStatistics()[id]; !proto.Equal(got, want) {
+			t.Errorf("column %d: got statistics %v; want %v", id, got, want)
+		}
+	}
+	mismatches, err := merged.VerifyStatistics(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range mismatches {
+		t.Errorf("statistics mismatch: %v", m)
+	}
+}
+
+func TestMergeJava(t *testing.T) {
+	f, err := Open("examples/TestOrcFile.testStripeLevelStats.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var buf bytes.Buffer
+	if err := Merge(&buf, f, f); err != nil {
+		t.Fatal(err)
+	}
+	merged := openBytes(t, buf.Bytes())
+	if merged.PostScript.GetWriterVersion() != f.PostScript.GetWriterVersion() {
+		t.Errorf("got writer version %d; want %d", merged.PostScript.GetWriterVersion(),
+			f.PostScript.GetWriterVersion())
+	}
+	for id, stats := range f.Footer.GetStatistics() {
+		if got, want := merged.Footer.GetStatistics()[id].GetNumberOfValues(), 2*stats.GetNumberOfValues(); got != want {
+			t.Errorf("column %d: got %d values; want %d", id, got, want)
+		}
+	}
+	if got, want := merged.Footer.GetStatistics()[1].GetIntStatistics().GetSum(),
+		2*f.Footer.GetStatistics()[1].GetIntStatistics().GetSum(); got != want {
+		t.Errorf("got sum %d; want %d", got, want)
+	}
+	rows := readAll(t, f)
+	if got := readAll(t, merged); !reflect.DeepEqual(got, append(rows, rows...)) {
+		t.Error("merged rows differ from those of the source file twice")
+	}
+}
+
+func TestMergeErrors(t *testing.T) {
+	write := func(types []*Type, compression CompressionKind) *File {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, types, &WriterOptions{Compression: compression})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return openBytes(t, buf.Bytes())
+	}
+	long := []*Type{{Kind: Type_LONG.Enum()}}
+	str := []*Type{{Kind: Type_STRING.Enum()}}
+	for _, test := range []struct {
+		srcs []*File
+		want string
+	}{
+		{nil, "no files"},
+		{[]*File{write(long, CompressionKind_ZLIB), write(str, CompressionKind_ZLIB)}, "type 0 is"},
+		{[]*File{write(long, CompressionKind_ZLIB), write(long, CompressionKind_SNAPPY)}, "compression SNAPPY"},
+	} {
+		err := Merge(ioutil.Discard, test.srcs...)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("got error %v; want %q", err, test.want)
+		}
+	}
+}
@@ -4,19 +4,25 @@ import (
 	"bytes"
 	"compress/flate"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
-	"math"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/golang/snappy"
+	"google.golang.org/protobuf/proto"
 )
 
+//go:generate protoc --go_out=. --go_opt=paths=source_relative orc_proto.proto
+
 const (
-	TAIL_SIZE_GUESS = 16 * 1024 // 16 KB, must be > 255
+	MAGIC           = "ORC"
+	TAIL_SIZE_GUESS = 16 * 1024 // 16 KB
 )
 
 type reader interface {
@@ -29,11 +35,26 @@ type File struct {
 	PostScript
 	Footer
 	metadata Metadata
+	// metadataOnce loads metadata on first use, recording any failure in metadataErr
+	metadataOnce sync.Once
+	metadataErr  error
 
 	length           int64
-	metadataLoaded   bool
 	postscriptLength int64
-	r                reader
+	// tail holds the end of the file read when opening it, from the metadata if the read reached it
+	tail []byte
+	r    reader
+
+	// variants holds the encryption variants of the file in footer order
+	variants []*encryptionVariant
+	// decrypted holds the variant each column is read from, nil for columns read unencrypted or masked
+	decrypted []*encryptionVariant
+	// stripeIDs holds the id of each stripe in the initialization vectors of its encrypted streams
+	stripeIDs []uint64
+	// masks holds the mask of each column, nil for columns read as they are
+	masks []*ColumnMask
+	// rebase reads the dates and timestamps of a file in the hybrid calendar with the dates they were written with
+	rebase bool
 }
 
 func (f *File) Close() {
@@ -48,225 +69,558 @@ func (f *File) PostScriptLength() int64 {
 	return f.postscriptLength
 }
 
-func (f *File) GetMetadata() *Metadata {
-	if !f.metadataLoaded {
-		if err := f.loadMetadata(nil); err != nil {
-			// TODO: figure out what do with the error
-			fmt.Println(err)
-			return nil
-		}
+// ErrNoStripeStatistics is returned for files without statistics for each stripe, such as those written before
+// Hive 0.12
+var ErrNoStripeStatistics = errors.New("file has no stripe statistics")
+
+// Metadata returns the metadata section of the file, which is read on first use. It is safe for concurrent use.
+func (f *File) Metadata() (*Metadata, error) {
+	f.metadataOnce.Do(func() {
+		f.metadataErr = f.loadMetadata()
+	})
+	if f.metadataErr != nil {
+		return nil, f.metadataErr
 	}
-	return &(f.metadata)
+	return &f.metadata, nil
 }
 
-var writerVersions = map[uint32]string{
-	1: "HIVE-8732",
-	2: "HIVE-4243",
-	3: "HIVE-12055",
-	4: "HIVE-13083",
+// StripeStatistics returns the column statistics of each stripe as Statistics does, from the metadata section of the
+// file
+func (f *File) StripeStatistics() ([]*StripeStatistics, error) {
+	stored, err := f.storedStripeStatistics()
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]*StripeStatistics, len(stored))
+	for i, stripe := range stored {
+		stats[i] = &StripeStatistics{ColStats: f.readAllStatistics(stripe.GetColStats())}
+	}
+	return stats, nil
 }
 
-func (f *File) WriterVersion() string {
-	v, ok := writerVersions[f.PostScript.GetWriterVersion()]
-	if !ok {
-		return "original"
+// storedStripeStatistics returns the column statistics of each stripe as the file stores them
+func (f *File) storedStripeStatistics() ([]*StripeStatistics, error) {
+	metadata, err := f.Metadata()
+	if err != nil {
+		return nil, err
 	}
-	return v
+	stats := metadata.GetStripeStats()
+	if len(stats) != len(f.Footer.GetStripes()) {
+		return nil, ErrNoStripeStatistics
+	}
+	return stats, nil
 }
 
+// Statistics returns the statistics of each column over the whole file as its values are read: without those its
+// writer is known to have computed wrongly, rebased and masked. Footer.Statistics holds them as the file stores them.
+func (f *File) Statistics() []*ColumnStatistics {
+	return f.readAllStatistics(f.Footer.GetStatistics())
+}
+
+// GetMetadata returns the metadata section of the file, or nil when it cannot be read.
+//
+// Deprecated: use Metadata, which reports why it cannot be read.
+func (f *File) GetMetadata() *Metadata {
+	metadata, _ := f.Metadata()
+	return metadata
+}
+
+// UserMetadata returns the metadata the writer of the file attached to it, by name
+func (f *File) UserMetadata() map[string][]byte {
+	metadata := make(map[string][]byte, len(f.Footer.GetMetadata()))
+	for _, item := range f.Footer.GetMetadata() {
+		metadata[item.GetName()] = item.GetValue()
+	}
+	return metadata
+}
+
+// UserMetadataValue returns the user metadata of the given name, ok reporting whether the file has it
+func (f *File) UserMetadataValue(name string) (value []byte, ok bool) {
+	// a name written more than once has its last value, as in the Java reader
+	for _, item := range f.Footer.GetMetadata() {
+		if item.GetName() == name {
+			value, ok = item.GetValue(), true
+		}
+	}
+	return value, ok
+}
+
+// OpenOptions configures how a file is opened
+type OpenOptions struct {
+	// TailSizeGuess is the number of bytes read from the end of the file to find its postscript, footer and
+	// metadata in a single read, defaulting to TAIL_SIZE_GUESS. Guesses below 256 bytes are raised to 256, the
+	// longest postscript and its length.
+	TailSizeGuess int64
+	// VersionPolicy decides whether files of a format version other than 0.11 and 0.12 are opened
+	VersionPolicy VersionPolicy
+	// Warn receives warnings about files opened despite problems, such as an unknown version, defaulting to
+	// log.Println
+	Warn func(err error)
+	// KeyProvider decrypts the encrypted columns whose master keys it has, the others reading as the masked values
+	// written in their place
+	KeyProvider KeyProvider
+	// Masks hide the values of sensitive columns, whether decrypted or not, before they reach the caller
+	Masks []ColumnMask
+	// RebaseCalendar reads the dates and timestamps of files in the hybrid Julian and Gregorian calendar, those of
+	// Hive before 3.1 among them, with the year, month and day they were written with as Spark does. By default they
+	// read as the days they stand for, which before 1582-10-15 have other dates in the proleptic Gregorian calendar
+	// of the time package.
+	RebaseCalendar bool
+}
+
+// VersionPolicy decides how files of an unknown format version, typically written by a future writer, are opened
+type VersionPolicy int
+
+const (
+	// VERSION_WARN opens the file, passing an UnsupportedError to OpenOptions.Warn
+	VERSION_WARN VersionPolicy = iota
+	// VERSION_ERROR refuses to open the file, returning an UnsupportedError
+	VERSION_ERROR
+	// VERSION_BEST_EFFORT opens the file silently
+	VERSION_BEST_EFFORT
+)
+
+// knownVersions holds the format versions this package reads
+var knownVersions = map[string]bool{"0.11": true, "0.12": true}
+
 // Open an ORC file, ready for reading
 func Open(filename string) (*File, error) {
+	return OpenWithOptions(filename, nil)
+}
+
+// OpenWithOptions opens an ORC file as configured by opts, which may be nil for the defaults
+func OpenWithOptions(filename string, opts *OpenOptions) (*File, error) {
 	f, err := os.Open(filename)
 	if err != nil {
 		return nil, err
 	}
 	info, err := f.Stat()
 	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	var o OpenOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.TailSizeGuess <= 0 {
+		o.TailSizeGuess = TAIL_SIZE_GUESS
+	}
+	if o.Warn == nil {
+		o.Warn = func(err error) { log.Println(err) }
+	}
+	file := &File{length: info.Size(), r: f}
+	if err := file.loadTail(o.TailSizeGuess); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if version := file.FormatVersion(); !knownVersions[version] && o.VersionPolicy != VERSION_BEST_EFFORT {
+		err := &UnsupportedError{Feature: "format version " + version}
+		if o.VersionPolicy == VERSION_ERROR {
+			f.Close()
+			return nil, err
+		}
+		o.Warn(fmt.Errorf("%s: %w, reading it may fail", filename, err))
+	}
+	if err := file.loadEncryption(o.KeyProvider); err != nil {
+		f.Close()
 		return nil, err
 	}
-	o := &File{length: info.Size(), r: f}
-	if err := load(o); err != nil {
+	if err := file.setMasks(o.Masks); err != nil {
+		f.Close()
 		return nil, err
 	}
-	return o, nil
+	file.rebase = o.RebaseCalendar && file.Calendar() == CalendarKind_JULIAN_GREGORIAN
+	return file, nil
 }
 
-func load(f *File) error {
-	if err := f.loadTail(); err != nil {
-		return err
+// FormatVersion returns the version of the file format, such as "0.12", files without one being of version 0.11
+func (f *File) FormatVersion() string {
+	version := f.PostScript.GetVersion()
+	if len(version) == 0 {
+		return "0.11"
 	}
-	return nil
+	parts := make([]string, len(version))
+	for i, v := range version {
+		parts[i] = strconv.FormatUint(uint64(v), 10)
+	}
+	return strings.Join(parts, ".")
 }
 
-// load postscript and footer
-// The Postscript section provides the necessary information to interpret the rest of the file including the length of
-// the file’s Footer and Metadata sections, the version of the file, and the kind of general compression used
-func (f *File) loadTail() error {
-	readSize := int64(math.Min(float64(TAIL_SIZE_GUESS), float64(f.length)))
-	buf := make([]byte, readSize)
+// SoftwareVersion returns the version of the library that wrote the file, such as "1.7.2", if it says
+func (f *File) SoftwareVersion() string {
+	return f.Footer.GetSoftwareVersion()
+}
 
-	n, err := f.r.ReadAt(buf, f.length-readSize)
-	if err != nil {
-		return fmt.Errorf("Failed to read %s: %s", f.r.Name(), err)
+// Calendar returns the calendar of the dates and timestamps of the file, files that do not say using the hybrid
+// Julian and Gregorian calendar
+func (f *File) Calendar() CalendarKind {
+	if c := f.Footer.GetCalendar(); c != CalendarKind_UNKNOWN_CALENDAR {
+		return c
 	}
-	if n < 4 {
-		return fmt.Errorf("Failed to read enough of %s to load tail", f.r.Name())
-	}
-
-	f.postscriptLength = int64(buf[n-1]) // uint8 is actually enough
+	return CalendarKind_JULIAN_GREGORIAN
+}
 
-	if err := proto.Unmarshal(buf[readSize-f.postscriptLength-1:readSize-1], &(f.PostScript)); err != nil {
-		return err
+// TypeAttributes returns the attributes of a column, as annotated by the writer
+func (f *File) TypeAttributes(column uint32) map[string]string {
+	types := f.Footer.GetTypes()
+	if int(column) >= len(types) {
+		return nil
+	}
+	attributes := make(map[string]string)
+	for _, pair := range types[column].GetAttributes() {
+		attributes[pair.GetKey()] = pair.GetValue()
 	}
+	return attributes
+}
 
-	if f.PostScript.GetMagic() != "ORC" {
-		return fmt.Errorf("unexpected magic: %s", f.PostScript.GetMagic())
+// FindColumn returns the id of the column at a path of field names separated by dots, such as "customer.email".
+// As in the Java library, the element of a list is named _elem, the key and value of a map _key and _value, and the
+// variants of a union by their index.
+func (f *File) FindColumn(path string) (uint32, error) {
+	types := f.Footer.GetTypes()
+	if path == "" || len(types) == 0 {
+		return 0, fmt.Errorf("column %q not in schema", path)
+	}
+	var id uint32
+	for _, name := range strings.Split(path, ".") {
+		t := types[id]
+		found := -1
+		switch t.GetKind() {
+		case Type_STRUCT:
+			for i, field := range t.GetFieldNames() {
+				if field == name {
+					found = i
+				}
+			}
+		case Type_LIST:
+			if name == "_elem" {
+				found = 0
+			}
+		case Type_MAP:
+			switch name {
+			case "_key":
+				found = 0
+			case "_value":
+				found = 1
+			}
+		case Type_UNION:
+			if i, err := strconv.Atoi(name); err == nil {
+				found = i
+			}
+		}
+		subtypes := t.GetSubtypes()
+		if found < 0 || found >= len(subtypes) || int(subtypes[found]) >= len(types) {
+			return 0, fmt.Errorf("column %q not in schema", path)
+		}
+		id = subtypes[found]
 	}
+	return id, nil
+}
 
-	return f.loadFooter(&buf)
+// ColumnPaths returns the path of each column as FindColumn takes it, that of the root column being empty
+func (f *File) ColumnPaths() []string {
+	types := f.Footer.GetTypes()
+	paths := make([]string, len(types))
+	for id, t := range types {
+		prefix := paths[id]
+		if prefix != "" {
+			prefix += "."
+		}
+		for i, sub := range t.GetSubtypes() {
+			if int(sub) <= id || int(sub) >= len(types) {
+				continue
+			}
+			var name string
+			switch t.GetKind() {
+			case Type_STRUCT:
+				if i < len(t.GetFieldNames()) {
+					name = t.GetFieldNames()[i]
+				}
+			case Type_LIST:
+				name = "_elem"
+			case Type_MAP:
+				name = []string{"_key", "_value"}[i%2]
+			default:
+				name = strconv.Itoa(i)
+			}
+			paths[sub] = prefix + name
+		}
+	}
+	return paths
 }
 
-// load footer
-// Once the Postscript is parsed, the compressed serialized length of the Footer is known and it can be decompressed
-// and parsed.
-func (f *File) loadFooter(tail *[]byte) error {
-	footerLength := int64(f.PostScript.GetFooterLength())
-	tailLength := 1 + f.postscriptLength + footerLength
-	readLength := int64(len(*tail))
+// load postscript and footer
+// The Postscript section provides the necessary information to interpret the rest of the file including the length of
+// the file’s Footer and Metadata sections, the version of the file, and the kind of general compression used
+func (f *File) loadTail(guess int64) error {
+	if f.length == 0 {
+		// Hive writes empty files for empty ORC files
+		f.emptyTail()
+		return nil
+	}
+	if f.length <= int64(len(MAGIC)) {
+		return corrupt("postscript", 0, fmt.Errorf("file of %d bytes too short", f.length))
+	}
 
-	var footerReader io.Reader
-	if tailLength > readLength {
-		// did not catch entire tail with first read, get more
-		// TODO: revisit reread vs read diff + concat original read
-		footerReader = io.NewSectionReader(f.r, f.length-tailLength, footerLength)
-	} else {
-		footerOffset := readLength - tailLength
-		footerReader = bytes.NewReader((*tail)[footerOffset : footerOffset+footerLength])
+	// the postscript length is a single byte, so reading 256 bytes always finds the postscript
+	if guess < 256 {
+		guess = 256
+	}
+	readSize := guess
+	if readSize > f.length {
+		readSize = f.length
+	}
+	tail := make([]byte, readSize)
+	if _, err := f.r.ReadAt(tail, f.length-readSize); err != nil {
+		return fmt.Errorf("while reading %s: %w", f.r.Name(), err)
 	}
 
-	if *(f.PostScript.Compression) != CompressionKind_NONE {
+	f.postscriptLength = int64(tail[readSize-1])
+	psOffset := f.length - 1 - f.postscriptLength
+	if f.postscriptLength == 0 || psOffset < int64(len(MAGIC)) {
+		return corrupt("postscript", 0, fmt.Errorf("postscript of %d bytes in a file of %d bytes",
+			f.postscriptLength, f.length))
+	}
+	if err := proto.Unmarshal(tail[readSize-1-f.postscriptLength:readSize-1], &(f.PostScript)); err != nil {
+		return corrupt("postscript", psOffset, err)
+	}
+	// very old files have no magic in their postscript, leaving the header to identify them
+	if magic := f.PostScript.GetMagic(); magic != MAGIC && magic != "" {
+		return corrupt("postscript", psOffset, fmt.Errorf("unexpected magic: %q", magic))
+	}
+	header := tail
+	if readSize < f.length {
 		var err error
-		footerReader, err = f.compressedReader(footerReader, footerLength)
-		if err != nil {
+		if header, err = f.readSection("header", 0, int64(len(MAGIC))); err != nil {
 			return err
 		}
 	}
+	if string(header[:len(MAGIC)]) != MAGIC {
+		return corrupt("header", 0, fmt.Errorf("unexpected magic: %q", header[:len(MAGIC)]))
+	}
 
-	footerBuf, err := ioutil.ReadAll(footerReader)
-	if err != nil {
-		return fmt.Errorf("while consuming footer: %s", err)
+	// the footer, metadata and encrypted stripe statistics lie between the header and the postscript
+	footerLength, metadataLength := f.PostScript.GetFooterLength(), f.PostScript.GetMetadataLength()
+	statisticsLength := f.PostScript.GetStripeStatisticsLength()
+	if available := uint64(psOffset) - uint64(len(MAGIC)); footerLength > available ||
+		metadataLength > available-footerLength || statisticsLength > available-footerLength-metadataLength {
+		return corrupt("postscript", psOffset, fmt.Errorf("footer of %d, metadata of %d and stripe statistics of %d "+
+			"bytes exceed the %d bytes before the postscript", footerLength, metadataLength, statisticsLength, available))
 	}
 
-	if err := proto.Unmarshal(footerBuf, &(f.Footer)); err != nil {
-		return fmt.Errorf("while unmarshaling footer: %s", err)
+	// read whatever the guess missed of the footer, the metadata being read when first needed
+	footerOffset := psOffset - int64(footerLength)
+	if missing := f.length - footerOffset - readSize; missing > 0 {
+		head, err := f.readSection("footer", footerOffset, missing)
+		if err != nil {
+			return err
+		}
+		tail = append(head, tail...)
+	}
+	// the stripes preceding the metadata are not kept
+	if keep := f.length - footerOffset + int64(metadataLength); int64(len(tail)) > keep {
+		tail = tail[int64(len(tail))-keep:]
 	}
+	f.tail = tail
 
+	if err := f.loadFooter(); err != nil {
+		return err
+	}
+	if length := f.Footer.HeaderLength; length != nil && *length != uint64(len(MAGIC)) {
+		return corrupt("footer", footerOffset, fmt.Errorf("header of %d bytes", *length))
+	}
+	if len(f.Footer.Types) == 0 {
+		return corrupt("footer", footerOffset, errors.New("no types"))
+	}
+	// stripes lie between the header and the stripe statistics
+	contentEnd := uint64(footerOffset) - metadataLength - statisticsLength
+	for i, info := range f.Footer.GetStripes() {
+		start := info.GetOffset()
+		end := start + info.GetIndexLength() + info.GetDataLength() + info.GetFooterLength()
+		if start < uint64(len(MAGIC)) || end > contentEnd || end < start {
+			return corrupt("footer", footerOffset, fmt.Errorf("stripe %d from %d to %d is outside of the %d bytes "+
+				"of stripes", i, start, end, contentEnd))
+		}
+	}
 	return nil
 }
 
-func (f *File) GetStripeFooter(i *StripeInformation) (*StripeFooter, error) {
-	start := int64(i.GetOffset() + i.GetIndexLength() + i.GetDataLength())
-	length := int64(i.GetFooterLength())
-	var r io.Reader = io.NewSectionReader(f.r, start, length)
+// emptyTail describes the file as holding no rows of an empty struct, as Java readers do for empty files
+func (f *File) emptyTail() {
+	f.PostScript = PostScript{
+		FooterLength: proto.Uint64(0),
+		Compression:  CompressionKind_NONE.Enum(),
+		Version:      []uint32{0, 12},
+		Magic:        proto.String(MAGIC),
+	}
+	f.Footer = Footer{
+		HeaderLength:  proto.Uint64(0),
+		ContentLength: proto.Uint64(0),
+		NumberOfRows:  proto.Uint64(0),
+		Types:         []*Type{{Kind: Type_STRUCT.Enum()}},
+	}
+	// nor is there metadata to load
+	f.metadataOnce.Do(func() {})
+}
 
-	if *(f.PostScript.Compression) != CompressionKind_NONE {
-		var err error
-		r, err = f.compressedReader(r, length)
-		if err != nil {
-			return nil, err
-		}
+// tailSection returns a section ending length bytes before the end of the file, from the tail read when opening
+// the file when it holds it
+func (f *File) tailSection(section string, end, length int64) ([]byte, error) {
+	offset := f.length - end - length
+	if start := int64(len(f.tail)) - end - length; start >= 0 {
+		return f.tail[start : start+length], nil
+	}
+	return f.readSection(section, offset, length)
+}
+
+// load footer
+// Once the Postscript is parsed, the compressed serialized length of the Footer is known and it can be decompressed
+// and parsed.
+func (f *File) loadFooter() error {
+	footerLength := int64(f.PostScript.GetFooterLength())
+	buf, err := f.tailSection("footer", 1+f.postscriptLength, footerLength)
+	if err != nil {
+		return err
 	}
+	return f.decodeSection("footer", f.length-1-f.postscriptLength-footerLength, buf, &(f.Footer))
+}
 
-	buf, err := ioutil.ReadAll(r)
+func (f *File) GetStripeFooter(i *StripeInformation) (*StripeFooter, error) {
+	start := int64(i.GetOffset() + i.GetIndexLength() + i.GetDataLength())
+	buf, err := f.readSection("stripe footer", start, int64(i.GetFooterLength()))
 	if err != nil {
-		return nil, fmt.Errorf("while consuming stripe footer: %s", err)
+		return nil, err
 	}
 
 	var footer StripeFooter
-	if err := proto.Unmarshal(buf, &footer); err != nil {
-		return nil, fmt.Errorf("while unmarshaling stripe footer: %s", err)
+	if err := f.decodeSection("stripe footer", start, buf, &footer); err != nil {
+		return nil, err
 	}
 
 	return &footer, nil
 }
 
 // load metadata
-func (f *File) loadMetadata(tail *[]byte) error {
-	if tail == nil {
-		t := make([]byte, 0)
-		tail = &t
-	}
-	footerLength := int64(f.PostScript.GetFooterLength())
+func (f *File) loadMetadata() error {
+	end := 1 + f.postscriptLength + int64(f.PostScript.GetFooterLength())
 	metadataLength := int64(f.PostScript.GetMetadataLength())
-	tailLength := 1 + f.postscriptLength + footerLength + metadataLength
-	readLength := int64(len(*tail))
+	buf, err := f.tailSection("metadata", end, metadataLength)
+	if err != nil {
+		return err
+	}
+	if err := f.decodeSection("metadata", f.length-end-metadataLength, buf, &(f.metadata)); err != nil {
+		return err
+	}
+	return f.decryptStripeStatistics()
+}
+
+// readStatistics returns the statistics of a column as its values are read: without those its writer is known to
+// have computed wrongly, rebased and masked as configured
+func (f *File) readStatistics(column uint32, s *ColumnStatistics) *ColumnStatistics {
+	return f.maskStatistics(column, f.rebaseStatistics(f.trustedStatistics(column, s)))
+}
+
+// readAllStatistics returns the statistics of each column as its values are read, leaving stats unchanged
+func (f *File) readAllStatistics(stats []*ColumnStatistics) []*ColumnStatistics {
+	read := make([]*ColumnStatistics, len(stats))
+	for id, s := range stats {
+		read[id] = f.readStatistics(uint32(id), s)
+	}
+	return read
+}
 
-	var metadataReader io.Reader
-	if tailLength > readLength {
-		// did not catch entire tail with first read, get more
-		// TODO: revisit reread vs read diff + concat original read
-		metadataReader = io.NewSectionReader(f.r, f.length-tailLength, metadataLength)
-	} else {
-		metdataOffset := readLength - tailLength
-		metadataReader = bytes.NewReader((*tail)[metdataOffset : metdataOffset+metadataLength])
+// footerOffset returns the position of the footer in the file
+func (f *File) footerOffset() int64 {
+	return f.length - 1 - f.postscriptLength - int64(f.PostScript.GetFooterLength())
+}
+
+// readSection reads length bytes at offset, reporting a section extending beyond the file as corrupt
+func (f *File) readSection(section string, offset, length int64) ([]byte, error) {
+	if offset < 0 || length < 0 || offset+length > f.length {
+		return nil, corrupt(section, offset, fmt.Errorf("%d bytes extend beyond the file of %d bytes", length, f.length))
+	}
+	buf := make([]byte, length)
+	if _, err := f.r.ReadAt(buf, offset); err != nil {
+		return nil, fmt.Errorf("while reading %s: %w", section, err)
 	}
+	return buf, nil
+}
 
-	if *(f.PostScript.Compression) != CompressionKind_NONE {
+// decodeSection decompresses and unmarshals a section of the file read from offset
+func (f *File) decodeSection(section string, offset int64, data []byte, m proto.Message) error {
+	if f.PostScript.GetCompression() != CompressionKind_NONE {
 		var err error
-		metadataReader, err = f.compressedReader(metadataReader, metadataLength)
-		if err != nil {
-			return err
+		if data, err = ioutil.ReadAll(f.compressedReader(bytes.NewReader(data))); err != nil {
+			return corrupt(section, offset, err)
 		}
 	}
-
-	metadataBuf, err := ioutil.ReadAll(metadataReader)
-	if err != nil {
-		return fmt.Errorf("while consuming metadata: %s", err)
+	if err := proto.Unmarshal(data, m); err != nil {
+		return corrupt(section, offset, err)
 	}
+	return nil
+}
 
-	if err := proto.Unmarshal(metadataBuf, &(f.metadata)); err != nil {
-		return fmt.Errorf("while unmarshaling metadata: %s", err)
-	}
+// compressedReader returns a reader over the decompressed contents of r, which holds a sequence of compression
+// chunks, each prefixed by a 3 byte header
+func (f *File) compressedReader(r io.Reader) io.Reader {
+	return &chunkReader{r: r, kind: f.PostScript.GetCompression()}
+}
 
-	f.metadataLoaded = true
-	return nil
+type chunkReader struct {
+	r     io.Reader
+	kind  CompressionKind
+	chunk io.Reader
 }
 
-func (f *File) compressedReader(r io.Reader, l int64) (io.Reader, error) {
-	buf := make([]byte, 3)
-	// TODO: handle short read
-	if _, err := r.Read(buf); err != nil {
-		return nil, err
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if c.chunk != nil {
+			n, err := c.chunk.Read(p)
+			if err == io.EOF {
+				c.chunk = nil
+				err = nil
+			}
+			if n > 0 || err != nil {
+				return n, err
+			}
+		}
+		if err := c.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (c *chunkReader) nextChunk() error {
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(c.r, buf[:3]); err != nil {
+		return err
 	}
-	buf = append(buf, 0)
 	header := binary.LittleEndian.Uint32(buf)
 
 	isOriginal := header & 1
-	compressedLength := header >> 1 // (header - isOriginal) / 2
-	if int64(compressedLength) != l-3 {
-		log.Printf("compressed length %d less than expected %d", compressedLength, l-3)
-		r = &io.LimitedReader{R: r, N: int64(compressedLength)}
-	}
-
-	if isOriginal == 0 {
-		switch *(f.PostScript.Compression) {
-		case CompressionKind_ZLIB:
-			r = flate.NewReader(r)
-		case CompressionKind_SNAPPY:
-			snap, err := ioutil.ReadAll(r)
-			if err != nil {
-				return nil, fmt.Errorf("while reading snappy bits: %s", err)
-			}
+	compressedLength := int64(header >> 1) // (header - isOriginal) / 2
+	chunk := make([]byte, compressedLength)
+	if _, err := io.ReadFull(c.r, chunk); err != nil {
+		return fmt.Errorf("while reading compressed chunk: %w", unexpected(err))
+	}
 
-			dst, err := snappy.Decode(nil, snap)
-			if err != nil {
-				return nil, fmt.Errorf("while decoding snappy bits: %s", err)
-			}
-			r = bytes.NewReader(dst)
-		default:
-			return nil, fmt.Errorf("unsupported compression: %s", f.PostScript.Compression.String())
-		}
+	if isOriginal == 1 {
+		c.chunk = bytes.NewReader(chunk)
+		return nil
 	}
 
-	return r, nil
+	switch c.kind {
+	case CompressionKind_ZLIB:
+		c.chunk = flate.NewReader(bytes.NewReader(chunk))
+	case CompressionKind_SNAPPY:
+		dst, err := snappy.Decode(nil, chunk)
+		if err != nil {
+			return fmt.Errorf("while decoding snappy bits: %w", err)
+		}
+		c.chunk = bytes.NewReader(dst)
+	default:
+		return &UnsupportedError{Feature: "compression " + c.kind.String()}
+	}
+	return nil
 }
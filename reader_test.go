@@ -1,6 +1,18 @@
 package orc
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
 
 func TestOpen(t *testing.T) {
 	testCases := []struct {
@@ -15,7 +27,6 @@ func TestOpen(t *testing.T) {
 		{"examples/TestOrcFile.testMemoryManagementV12.orc", CompressionKind_NONE},
 		{"examples/TestOrcFile.testPredicatePushdown.orc", CompressionKind_NONE},
 		{"examples/TestOrcFile.testSeek.orc", CompressionKind_ZLIB},
-		// Skipped!
 		{"examples/TestOrcFile.testSnappy.orc", CompressionKind_SNAPPY},
 		{"examples/TestOrcFile.testStringAndBinaryStatistics.orc", CompressionKind_ZLIB},
 		{"examples/TestOrcFile.testStripeLevelStats.orc", CompressionKind_ZLIB},
@@ -40,10 +51,6 @@ func TestOpen(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.filename, func(t *testing.T) {
-			if tc.filename == "examples/TestOrcFile.testSnappy.orc" {
-				t.Skip("skipped", tc.filename)
-				return
-			}
 			o, err := Open(tc.filename)
 			if err != nil {
 				t.Error(err)
@@ -56,3 +63,306 @@ func TestOpen(t *testing.T) {
 		})
 	}
 }
+
+func TestUserMetadata(t *testing.T) {
+	o, err := Open("examples/TestOrcFile.metaData.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Close()
+	metadata := o.UserMetadata()
+	if len(metadata) != 3 {
+		t.Errorf("got %d user metadata values; want 3", len(metadata))
+	}
+	want := map[string][]byte{
+		"my.meta": {1, 2, 3, 4, 5, 6, 7, 0xff, 0xfe, 0x7f, 0x80},
+		"clobber": {5, 7, 11, 13, 17, 19},
+	}
+	for name, value := range want {
+		if got := metadata[name]; !bytes.Equal(got, value) {
+			t.Errorf("%s: got %v; want %v", name, got, value)
+		}
+		if got, ok := o.UserMetadataValue(name); !ok || !bytes.Equal(got, value) {
+			t.Errorf("%s: got %v, %t; want %v", name, got, ok, value)
+		}
+	}
+	if big, _ := o.UserMetadataValue("big"); len(big) != 40000 {
+		t.Errorf("got %d bytes of big; want 40000", len(big))
+	}
+	if _, ok := o.UserMetadataValue("missing"); ok {
+		t.Error("found missing user metadata")
+	}
+}
+
+func TestFileStripeStatistics(t *testing.T) {
+	o, err := Open("examples/TestOrcFile.testStripeLevelStats.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Close()
+	// loaded once for concurrent callers
+	results := make(chan *Metadata)
+	for i := 0; i < 4; i++ {
+		go func() {
+			metadata, err := o.Metadata()
+			if err != nil {
+				t.Error(err)
+			}
+			results <- metadata
+		}()
+	}
+	shared := <-results
+	for i := 1; i < 4; i++ {
+		if metadata := <-results; metadata != shared {
+			t.Error("got metadata not shared")
+		}
+	}
+	first, err := o.StripeStatistics()
+	if err != nil || len(first) != 3 {
+		t.Fatalf("got statistics of %d stripes: %v", len(first), err)
+	}
+	if got := first[0].GetColStats()[1].GetNumberOfValues(); got != 5000 {
+		t.Errorf("got %d values in the first stripe; want 5000", got)
+	}
+
+	for _, test := range []struct {
+		filename string
+		want     string
+	}{
+		{"examples/orc-file-11-format.orc", ErrNoStripeStatistics.Error()},
+		{"examples/TestVectorOrcFile.testLzo.orc", "unsupported compression LZO"},
+	} {
+		o, err := Open(test.filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer o.Close()
+		for i := 0; i < 2; i++ {
+			if _, err := o.StripeStatistics(); err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("%s: got error %v; want %q", test.filename, err, test.want)
+			}
+		}
+	}
+}
+
+func TestOpenTailSizes(t *testing.T) {
+	for _, filename := range []string{
+		"examples/TestOrcFile.emptyFile.orc",
+		"examples/TestOrcFile.metaData.orc",
+		"examples/demo-11-zlib.orc",
+		"examples/TestOrcFile.testSeek.orc",
+	} {
+		want, err := Open(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer want.Close()
+		wantStats, err := want.StripeStatistics()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, guess := range []int64{1, 300, 4096, 1 << 30} {
+			f, err := OpenWithOptions(filename, &OpenOptions{TailSizeGuess: guess})
+			if err != nil {
+				t.Fatalf("%s with guess %d: %v", filename, guess, err)
+			}
+			if !proto.Equal(&f.Footer, &want.Footer) {
+				t.Errorf("%s with guess %d: footers differ", filename, guess)
+			}
+			stats, err := f.StripeStatistics()
+			if err != nil {
+				t.Fatalf("%s with guess %d: %v", filename, guess, err)
+			}
+			if !proto.Equal(&Metadata{StripeStats: stats}, &Metadata{StripeStats: wantStats}) {
+				t.Errorf("%s with guess %d: stripe statistics differ", filename, guess)
+			}
+			f.Close()
+		}
+	}
+
+	// metadata within the tail read on opening is not read again
+	f, err := Open("examples/TestOrcFile.testStripeLevelStats.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if stats, err := f.StripeStatistics(); err != nil || len(stats) != 3 {
+		t.Errorf("got statistics of %d stripes, %v after closing the file; want 3", len(stats), err)
+	}
+}
+
+func TestOpenEmptyFile(t *testing.T) {
+	f := openBytes(t, nil)
+	if f.Footer.GetNumberOfRows() != 0 || len(f.Footer.GetStripes()) != 0 {
+		t.Errorf("got %d rows in %d stripes", f.Footer.GetNumberOfRows(), len(f.Footer.GetStripes()))
+	}
+	if kind := f.Footer.GetTypes()[0].GetKind(); kind != Type_STRUCT {
+		t.Errorf("got root type %s; want STRUCT", kind)
+	}
+	if stats, err := f.StripeStatistics(); err != nil || len(stats) != 0 {
+		t.Errorf("got statistics of %d stripes, %v", len(stats), err)
+	}
+	rows := f.Rows(context.Background(), nil)
+	defer rows.Close()
+	if rows.Next() {
+		t.Error("read a row of an empty file")
+	}
+	if err := rows.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOpenTruncated(t *testing.T) {
+	data, err := ioutil.ReadFile("examples/TestOrcFile.testStripeLevelStats.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "truncated.orc")
+	for n := 1; n < len(data); n += 1 + n/64 {
+		for _, truncated := range [][]byte{data[:n], data[len(data)-n:]} {
+			if err := ioutil.WriteFile(path, truncated, 0644); err != nil {
+				t.Fatal(err)
+			}
+			f, err := Open(path)
+			if err == nil {
+				f.Close()
+			}
+			if !errors.Is(err, ErrCorrupt) {
+				t.Fatalf("%d of %d bytes: got %v; want a corrupt file error", n, len(data), err)
+			}
+		}
+	}
+}
+
+func TestOpenVersionPolicy(t *testing.T) {
+	const filename = "examples/version1999.orc"
+	for _, test := range []struct {
+		policy VersionPolicy
+		err    bool
+		warned bool
+	}{
+		{VERSION_WARN, false, true},
+		{VERSION_ERROR, true, false},
+		{VERSION_BEST_EFFORT, false, false},
+	} {
+		var warnings []error
+		f, err := OpenWithOptions(filename, &OpenOptions{
+			VersionPolicy: test.policy,
+			Warn:          func(err error) { warnings = append(warnings, err) },
+		})
+		if test.err {
+			var u *UnsupportedError
+			if !errors.As(err, &u) || u.Feature != "format version 19.99" {
+				t.Errorf("policy %d: got %v; want an unsupported version error", test.policy, err)
+			}
+		} else if err != nil {
+			t.Errorf("policy %d: %v", test.policy, err)
+		} else {
+			if v := f.FormatVersion(); v != "19.99" {
+				t.Errorf("policy %d: got version %s; want 19.99", test.policy, v)
+			}
+			f.Close()
+		}
+		if warned := len(warnings) == 1 && errors.Is(warnings[0], ErrUnsupported); warned != test.warned ||
+			len(warnings) > 1 {
+			t.Errorf("policy %d: got warnings %v", test.policy, warnings)
+		}
+	}
+
+	// warnings are logged by default
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+	f, err := Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if !strings.Contains(logged.String(), "unsupported format version 19.99") {
+		t.Errorf("logged %q; want the unsupported version", logged.String())
+	}
+
+	f, err = OpenWithOptions("examples/TestOrcFile.test1.orc", &OpenOptions{VersionPolicy: VERSION_ERROR})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if v := f.FormatVersion(); v != "0.12" {
+		t.Errorf("got version %s; want 0.12", v)
+	}
+}
+
+func TestOpenHeader(t *testing.T) {
+	data := writeTestFile(t, CompressionKind_NONE)
+
+	broken := append([]byte(nil), data...)
+	broken[1] = 'X'
+	_, err := openCorrupt(t, broken)
+	checkCorrupt(t, err, "header", 0)
+
+	// very old files have no magic in their postscript, the header alone identifying them
+	psLength := int(data[len(data)-1])
+	psOffset := len(data) - 1 - psLength
+	var ps PostScript
+	if err := proto.Unmarshal(data[psOffset:len(data)-1], &ps); err != nil {
+		t.Fatal(err)
+	}
+	ps.Magic = nil
+	buf, err := proto.Marshal(&ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := append(append(append([]byte(nil), data[:psOffset]...), buf...), byte(len(buf)))
+	f, err := openCorrupt(t, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Footer.GetNumberOfRows(); got != 1000 {
+		t.Errorf("got %d rows; want 1000", got)
+	}
+	old[0] = 'X'
+	_, err = openCorrupt(t, old)
+	checkCorrupt(t, err, "header", 0)
+
+	ps.Magic = proto.String("CRO")
+	if buf, err = proto.Marshal(&ps); err != nil {
+		t.Fatal(err)
+	}
+	_, err = openCorrupt(t, append(append(append([]byte(nil), data[:psOffset]...), buf...), byte(len(buf))))
+	checkCorrupt(t, err, "postscript", int64(psOffset))
+}
+
+func TestFileProperties(t *testing.T) {
+	types := []*Type{{Kind: Type_LONG.Enum(), Attributes: []*StringPair{
+		{Key: proto.String("iceberg.id"), Value: proto.String("1")},
+		{Key: proto.String("comment"), Value: proto.String("a long")},
+	}}}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, types, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f := openBytes(t, buf.Bytes())
+	if got, want := f.TypeAttributes(0), map[string]string{"iceberg.id": "1", "comment": "a long"}; len(got) != 2 ||
+		got["iceberg.id"] != want["iceberg.id"] || got["comment"] != want["comment"] {
+		t.Errorf("got attributes %v; want %v", got, want)
+	}
+	if got := f.TypeAttributes(1); got != nil {
+		t.Errorf("got attributes %v of a missing column", got)
+	}
+	if c := f.Calendar(); c != CalendarKind_PROLEPTIC_GREGORIAN {
+		t.Errorf("got calendar %s", c)
+	}
+	if v := f.SoftwareVersion(); v != "" {
+		t.Errorf("got software version %q", v)
+	}
+
+	// files that do not say are in the hybrid calendar
+	f = &File{Footer: Footer{SoftwareVersion: proto.String("1.9.1")}}
+	if c, v := f.Calendar(), f.SoftwareVersion(); c != CalendarKind_JULIAN_GREGORIAN || v != "1.9.1" {
+		t.Errorf("got calendar %s and software version %q", c, v)
+	}
+}
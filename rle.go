@@ -0,0 +1,496 @@
+package orc
+
+import (
+	"fmt"
+	"io"
+)
+
+// readUvarint reads a base 128 varint
+func readUvarint(r io.ByteReader) (uint64, error) {
+	var (
+		v     uint64
+		shift uint
+	)
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF && shift > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if shift >= 64 {
+			return 0, fmt.Errorf("varint overflows 64 bits")
+		}
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, nil
+		}
+		shift += 7
+	}
+}
+
+// readVarint reads a zigzag encoded base 128 varint
+func readVarint(r io.ByteReader) (int64, error) {
+	v, err := readUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	return unZigzag(v), nil
+}
+
+func unZigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// readBigEndian reads an n byte big endian unsigned integer
+func readBigEndian(r io.ByteReader, n int) (uint64, error) {
+	var v uint64
+	for i := 0; i < n; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
+
+// byteRLE decodes the byte run length encoding used for byte columns, union tags and, with one bit per value,
+// boolean streams.
+type byteRLE struct {
+	r       io.ByteReader
+	literal bool
+	left    int
+	value   byte
+}
+
+func newByteRLE(r io.ByteReader) *byteRLE {
+	return &byteRLE{r: r}
+}
+
+func (d *byteRLE) next() (byte, error) {
+	if d.left == 0 {
+		control, err := d.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if int8(control) >= 0 {
+			d.literal = false
+			d.left = int(control) + 3
+			if d.value, err = d.r.ReadByte(); err != nil {
+				return 0, unexpected(err)
+			}
+		} else {
+			d.literal = true
+			d.left = -int(int8(control))
+		}
+	}
+	d.left--
+	if d.literal {
+		b, err := d.r.ReadByte()
+		return b, unexpected(err)
+	}
+	return d.value, nil
+}
+
+// boolRLE decodes a byte run length encoded stream of bits, most significant bit first
+type boolRLE struct {
+	bytes *byteRLE
+	bits  byte
+	left  uint
+}
+
+func newBoolRLE(r io.ByteReader) *boolRLE {
+	return &boolRLE{bytes: newByteRLE(r)}
+}
+
+func (d *boolRLE) next() (bool, error) {
+	if d.left == 0 {
+		b, err := d.bytes.next()
+		if err != nil {
+			return false, err
+		}
+		d.bits = b
+		d.left = 8
+	}
+	d.left--
+	return d.bits&(1<<d.left) != 0, nil
+}
+
+// intDecoder yields integers from an integer run length encoded stream. Unsigned streams return the bit pattern of
+// the unsigned value.
+type intDecoder interface {
+	next() (int64, error)
+}
+
+func newIntDecoder(r io.ByteReader, encoding ColumnEncoding_Kind, signed bool) intDecoder {
+	switch encoding {
+	case ColumnEncoding_DIRECT_V2, ColumnEncoding_DICTIONARY_V2:
+		return &intRLEv2{r: r, signed: signed}
+	default:
+		return &intRLEv1{r: r, signed: signed}
+	}
+}
+
+// intRLEv1 decodes the original Hive 0.11 integer run length encoding
+type intRLEv1 struct {
+	r       io.ByteReader
+	signed  bool
+	literal bool
+	left    int
+	value   int64
+	delta   int64
+}
+
+func (d *intRLEv1) varint() (int64, error) {
+	if d.signed {
+		return readVarint(d.r)
+	}
+	v, err := readUvarint(d.r)
+	return int64(v), err
+}
+
+func (d *intRLEv1) next() (int64, error) {
+	if d.left == 0 {
+		control, err := d.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if int8(control) >= 0 {
+			d.literal = false
+			d.left = int(control) + 3
+			delta, err := d.r.ReadByte()
+			if err != nil {
+				return 0, unexpected(err)
+			}
+			d.delta = int64(int8(delta))
+			if d.value, err = d.varint(); err != nil {
+				return 0, unexpected(err)
+			}
+			d.left--
+			return d.value, nil
+		}
+		d.literal = true
+		d.left = -int(int8(control))
+	}
+	d.left--
+	if d.literal {
+		v, err := d.varint()
+		return v, unexpected(err)
+	}
+	d.value += d.delta
+	return d.value, nil
+}
+
+// sub-encodings of integer run length encoding version 2
+const (
+	rleShortRepeat = iota
+	rleDirect
+	rlePatchedBase
+	rleDelta
+)
+
+// intRLEv2 decodes the Hive 0.12 integer run length encoding
+type intRLEv2 struct {
+	r        io.ByteReader
+	signed   bool
+	literals []int64
+	pos      int
+}
+
+func (d *intRLEv2) next() (int64, error) {
+	if d.pos == len(d.literals) {
+		if err := d.readRun(); err != nil {
+			return 0, err
+		}
+	}
+	v := d.literals[d.pos]
+	d.pos++
+	return v, nil
+}
+
+func (d *intRLEv2) readRun() error {
+	header, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	d.literals = d.literals[:0]
+	d.pos = 0
+	switch header >> 6 {
+	case rleShortRepeat:
+		err = d.shortRepeat(header)
+	case rleDirect:
+		err = d.direct(header)
+	case rlePatchedBase:
+		err = d.patchedBase(header)
+	case rleDelta:
+		err = d.delta(header)
+	}
+	return unexpected(err)
+}
+
+func (d *intRLEv2) shortRepeat(header byte) error {
+	width := int(header>>3&0x07) + 1
+	count := int(header&0x07) + 3
+	u, err := readBigEndian(d.r, width)
+	if err != nil {
+		return err
+	}
+	v := int64(u)
+	if d.signed {
+		v = unZigzag(u)
+	}
+	for i := 0; i < count; i++ {
+		d.literals = append(d.literals, v)
+	}
+	return nil
+}
+
+// runLength reads the 9 bit length shared by the direct, patched base and delta sub-encodings
+func (d *intRLEv2) runLength(header byte) (int, error) {
+	b, err := d.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	return int(header&0x01)<<8 | int(b), nil
+}
+
+func (d *intRLEv2) direct(header byte) error {
+	width := decodeBitWidth(int(header >> 1 & 0x1f))
+	length, err := d.runLength(header)
+	if err != nil {
+		return err
+	}
+	length++
+	if err := d.unpack(length, width); err != nil {
+		return err
+	}
+	if d.signed {
+		for i, v := range d.literals {
+			d.literals[i] = unZigzag(uint64(v))
+		}
+	}
+	return nil
+}
+
+func (d *intRLEv2) patchedBase(header byte) error {
+	width := decodeBitWidth(int(header >> 1 & 0x1f))
+	length, err := d.runLength(header)
+	if err != nil {
+		return err
+	}
+	length++
+
+	third, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	fourth, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	baseWidth := int(third>>5&0x07) + 1
+	patchWidth := decodeBitWidth(int(third & 0x1f))
+	gapWidth := int(fourth>>5&0x07) + 1
+	patchLength := int(fourth & 0x1f)
+
+	u, err := readBigEndian(d.r, baseWidth)
+	if err != nil {
+		return err
+	}
+	// the base is stored in sign-magnitude form
+	mask := uint64(1) << uint(baseWidth*8-1)
+	base := int64(u)
+	if u&mask != 0 {
+		base = -int64(u &^ mask)
+	}
+
+	if err := d.unpack(length, width); err != nil {
+		return err
+	}
+	patches, err := unpackInts(d.r, patchLength, closestFixedBits(patchWidth+gapWidth))
+	if err != nil {
+		return err
+	}
+	if patchWidth+width > 64 {
+		return fmt.Errorf("patch width %d too large for values of width %d", patchWidth, width)
+	}
+
+	patchMask := uint64(1)<<uint(patchWidth) - 1
+	idx := 0
+	for p := 0; p < len(patches); p++ {
+		gap := int(patches[p] >> uint(patchWidth))
+		patch := patches[p] & patchMask
+		idx += gap
+		if gap == 255 && patch == 0 {
+			// filler entry for gaps wider than the gap width allows
+			continue
+		}
+		if idx >= len(d.literals) {
+			return fmt.Errorf("patch position %d out of range", idx)
+		}
+		d.literals[idx] |= int64(patch << uint(width))
+	}
+	for i := range d.literals {
+		d.literals[i] += base
+	}
+	return nil
+}
+
+func (d *intRLEv2) delta(header byte) error {
+	var width int
+	if encoded := int(header >> 1 & 0x1f); encoded != 0 {
+		width = decodeBitWidth(encoded)
+	}
+	length, err := d.runLength(header)
+	if err != nil {
+		return err
+	}
+
+	var first int64
+	if d.signed {
+		first, err = readVarint(d.r)
+	} else {
+		var u uint64
+		u, err = readUvarint(d.r)
+		first = int64(u)
+	}
+	if err != nil {
+		return err
+	}
+	deltaBase, err := readVarint(d.r)
+	if err != nil {
+		return err
+	}
+
+	d.literals = append(d.literals, first)
+	if width == 0 {
+		// fixed delta
+		for i := 0; i < length; i++ {
+			d.literals = append(d.literals, d.literals[len(d.literals)-1]+deltaBase)
+		}
+		return nil
+	}
+
+	d.literals = append(d.literals, first+deltaBase)
+	deltas, err := unpackInts(d.r, length-1, width)
+	if err != nil {
+		return err
+	}
+	for _, delta := range deltas {
+		prev := d.literals[len(d.literals)-1]
+		if deltaBase < 0 {
+			d.literals = append(d.literals, prev-int64(delta))
+		} else {
+			d.literals = append(d.literals, prev+int64(delta))
+		}
+	}
+	return nil
+}
+
+// unpack appends n bit packed values to the run literals
+func (d *intRLEv2) unpack(n, width int) error {
+	values, err := unpackInts(d.r, n, width)
+	if err != nil {
+		return err
+	}
+	for _, v := range values {
+		d.literals = append(d.literals, int64(v))
+	}
+	return nil
+}
+
+// unpackInts reads n big endian bit packed values of the given width. Trailing bits of the final byte are discarded.
+func unpackInts(r io.ByteReader, n, width int) ([]uint64, error) {
+	values := make([]uint64, n)
+	var (
+		current byte
+		left    int
+	)
+	for i := range values {
+		var v uint64
+		need := width
+		for need > 0 {
+			if left == 0 {
+				b, err := r.ReadByte()
+				if err != nil {
+					return nil, err
+				}
+				current = b
+				left = 8
+			}
+			take := need
+			if take > left {
+				take = left
+			}
+			bits := current >> uint(left-take) & byte(1<<uint(take)-1)
+			v = v<<uint(take) | uint64(bits)
+			left -= take
+			need -= take
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// decodeBitWidth maps the 5 bit encoded width of integer run length encoding version 2 to a number of bits
+func decodeBitWidth(n int) int {
+	switch {
+	case n <= 23:
+		return n + 1
+	case n == 24:
+		return 26
+	case n == 25:
+		return 28
+	case n == 26:
+		return 30
+	case n == 27:
+		return 32
+	case n == 28:
+		return 40
+	case n == 29:
+		return 48
+	case n == 30:
+		return 56
+	default:
+		return 64
+	}
+}
+
+// closestFixedBits rounds a bit width up to one representable by the 5 bit encoded width
+func closestFixedBits(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n <= 24:
+		return n
+	case n <= 26:
+		return 26
+	case n <= 28:
+		return 28
+	case n <= 30:
+		return 30
+	case n <= 32:
+		return 32
+	case n <= 40:
+		return 40
+	case n <= 48:
+		return 48
+	case n <= 56:
+		return 56
+	default:
+		return 64
+	}
+}
+
+// unexpected converts io.EOF, found in the middle of a run, into io.ErrUnexpectedEOF
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,84 @@
+package orc
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestIntRLE(t *testing.T) {
+	testCases := []struct {
+		name     string
+		encoding ColumnEncoding_Kind
+		signed   bool
+		data     []byte
+		want     []int64
+	}{
+		{"v1 run", ColumnEncoding_DIRECT, false, []byte{0x61, 0xff, 0x64}, descending(100, 1)},
+		{"v1 literals", ColumnEncoding_DIRECT, false, []byte{0xfb, 0x02, 0x03, 0x04, 0x07, 0x0b}, []int64{2, 3, 4, 7, 11}},
+		{"v2 short repeat", ColumnEncoding_DIRECT_V2, false, []byte{0x0a, 0x27, 0x10}, []int64{10000, 10000, 10000, 10000, 10000}},
+		{"v2 direct", ColumnEncoding_DIRECT_V2, false,
+			[]byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+			[]int64{23713, 43806, 57005, 48879}},
+		{"v2 patched base", ColumnEncoding_DIRECT_V2, false,
+			[]byte{0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28, 0x32, 0x3c, 0x46, 0x50, 0x5a, 0x64,
+				0x6e, 0x78, 0x82, 0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8},
+			[]int64{2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090, 2100, 2110, 2120, 2130, 2140, 2150,
+				2160, 2170, 2180, 2190}},
+		{"v2 delta", ColumnEncoding_DIRECT_V2, false,
+			[]byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46},
+			[]int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}},
+		{"v2 fixed delta", ColumnEncoding_DIRECT_V2, true, []byte{0xc0, 0x04, 0x01, 0x01}, []int64{-1, -2, -3, -4, -5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := newIntDecoder(bytes.NewReader(tc.data), tc.encoding, tc.signed)
+			var got []int64
+			for range tc.want {
+				v, err := d.next()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, v)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v; want %v", got, tc.want)
+			}
+			if _, err := d.next(); err == nil {
+				t.Error("expected end of stream")
+			}
+		})
+	}
+}
+
+func descending(from, to int64) []int64 {
+	var values []int64
+	for v := from; v >= to; v-- {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestByteRLE(t *testing.T) {
+	d := newByteRLE(bytes.NewReader([]byte{0x61, 0x00, 0xfe, 0x44, 0x45}))
+	for i := 0; i < 100; i++ {
+		if b, err := d.next(); err != nil || b != 0 {
+			t.Fatalf("got %d, %v at %d; want 0", b, err, i)
+		}
+	}
+	for _, want := range []byte{0x44, 0x45} {
+		if b, err := d.next(); err != nil || b != want {
+			t.Fatalf("got %d, %v; want %d", b, err, want)
+		}
+	}
+}
+
+func TestBoolRLE(t *testing.T) {
+	d := newBoolRLE(bytes.NewReader([]byte{0xff, 0x80}))
+	for i, want := range []bool{true, false, false, false, false, false, false, false} {
+		if b, err := d.next(); err != nil || b != want {
+			t.Fatalf("got %t, %v at %d; want %t", b, err, i, want)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package orc
+
+import (
+	"math/bits"
+)
+
+const (
+	// minRepeat is the shortest run written as a repeat rather than literals
+	minRepeat = 3
+	// maxByteLiterals and maxByteRepeat bound the runs of byte run length encoding
+	maxByteLiterals = 128
+	maxByteRepeat   = 127 + minRepeat
+	// maxIntRun bounds the runs of integer run length encoding version 2
+	maxIntRun = 512
+	// maxShortRepeat is the longest run written with the short repeat sub-encoding
+	maxShortRepeat = 10
+)
+
+func writeUvarint(out *outStream, v uint64) {
+	for v >= 0x80 {
+		out.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	out.WriteByte(byte(v))
+}
+
+func writeVarint(out *outStream, v int64) {
+	writeUvarint(out, zigzag(v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+// byteRLEWriter encodes the byte run length encoding read by byteRLE
+type byteRLEWriter struct {
+	out      *outStream
+	literals []byte
+	repeat   bool
+	// tailRun counts the identical values at the end of literals
+	tailRun int
+}
+
+func newByteRLEWriter(out *outStream) *byteRLEWriter {
+	return &byteRLEWriter{out: out, literals: make([]byte, 0, maxByteLiterals)}
+}
+
+func (e *byteRLEWriter) write(b byte) {
+	n := len(e.literals)
+	switch {
+	case n == 0:
+		e.literals = append(e.literals, b)
+		e.tailRun = 1
+	case e.repeat:
+		if b == e.literals[0] {
+			e.literals = append(e.literals, b)
+			if len(e.literals) == maxByteRepeat {
+				e.flush()
+			}
+		} else {
+			e.flush()
+			e.literals = append(e.literals, b)
+			e.tailRun = 1
+		}
+	default:
+		if b == e.literals[n-1] {
+			e.tailRun++
+		} else {
+			e.tailRun = 1
+		}
+		if e.tailRun == minRepeat {
+			// the trailing literals become the start of a repeat
+			if n+1 > minRepeat {
+				e.literals = e.literals[:n+1-minRepeat]
+				e.flush()
+			} else {
+				e.literals = e.literals[:0]
+			}
+			for i := 0; i < minRepeat; i++ {
+				e.literals = append(e.literals, b)
+			}
+			e.repeat = true
+			return
+		}
+		e.literals = append(e.literals, b)
+		if len(e.literals) == maxByteLiterals {
+			e.flush()
+		}
+	}
+}
+
+// position appends the position of the next value: that of the run it will be written in and the number of values
+// buffered before it
+func (e *byteRLEWriter) position(positions []uint64) []uint64 {
+	return append(e.out.position(positions), uint64(len(e.literals)))
+}
+
+// flush writes any buffered values
+func (e *byteRLEWriter) flush() {
+	if len(e.literals) == 0 {
+		return
+	}
+	if e.repeat {
+		e.out.WriteByte(byte(len(e.literals) - minRepeat))
+		e.out.WriteByte(e.literals[0])
+	} else {
+		e.out.WriteByte(byte(-int8(len(e.literals))))
+		e.out.Write(e.literals)
+	}
+	e.literals = e.literals[:0]
+	e.repeat = false
+	e.tailRun = 0
+}
+
+// boolWriter packs bits, most significant first, into a byte run length encoded stream
+type boolWriter struct {
+	bytes *byteRLEWriter
+	bits  byte
+	count uint
+}
+
+func newBoolWriter(out *outStream) *boolWriter {
+	return &boolWriter{bytes: newByteRLEWriter(out)}
+}
+
+func (e *boolWriter) write(b bool) {
+	if b {
+		e.bits |= 1 << (7 - e.count)
+	}
+	e.count++
+	if e.count == 8 {
+		e.bytes.write(e.bits)
+		e.bits = 0
+		e.count = 0
+	}
+}
+
+// position appends the position of the byte holding the next value and the number of bits before it
+func (e *boolWriter) position(positions []uint64) []uint64 {
+	return append(e.bytes.position(positions), uint64(e.count))
+}
+
+// flush writes any buffered values, padding the final byte with zeros
+func (e *boolWriter) flush() {
+	if e.count > 0 {
+		e.bytes.write(e.bits)
+		e.bits = 0
+		e.count = 0
+	}
+	e.bytes.flush()
+}
+
+// intRLEv2Writer encodes integers with run length encoding version 2, using the short repeat, direct and delta
+// sub-encodings
+type intRLEv2Writer struct {
+	out      *outStream
+	signed   bool
+	literals []int64
+	// runValue is repeated runCount times following the literals
+	runValue int64
+	runCount int
+}
+
+func newIntRLEv2Writer(out *outStream, signed bool) *intRLEv2Writer {
+	return &intRLEv2Writer{out: out, signed: signed, literals: make([]int64, 0, maxIntRun)}
+}
+
+func (e *intRLEv2Writer) write(v int64) {
+	if e.runCount > 0 {
+		if v == e.runValue && e.runCount < maxIntRun {
+			e.runCount++
+			return
+		}
+		e.writeRun()
+	}
+
+	e.literals = append(e.literals, v)
+	n := len(e.literals)
+	if n >= minRepeat && e.literals[n-2] == v && e.literals[n-3] == v {
+		e.literals = e.literals[:n-minRepeat]
+		e.writeLiterals()
+		e.runValue = v
+		e.runCount = minRepeat
+		return
+	}
+	if n == maxIntRun {
+		e.writeLiterals()
+	}
+}
+
+// position appends the position of the next value, as for byteRLEWriter
+func (e *intRLEv2Writer) position(positions []uint64) []uint64 {
+	return append(e.out.position(positions), uint64(len(e.literals)+e.runCount))
+}
+
+// flush writes any buffered values
+func (e *intRLEv2Writer) flush() {
+	e.writeLiterals()
+	e.writeRun()
+}
+
+func (e *intRLEv2Writer) encode(v int64) uint64 {
+	if e.signed {
+		return zigzag(v)
+	}
+	return uint64(v)
+}
+
+func (e *intRLEv2Writer) writeFirst(v int64) {
+	if e.signed {
+		writeVarint(e.out, v)
+	} else {
+		writeUvarint(e.out, uint64(v))
+	}
+}
+
+func (e *intRLEv2Writer) writeRun() {
+	if e.runCount == 0 {
+		return
+	}
+	if e.runCount <= maxShortRepeat {
+		u := e.encode(e.runValue)
+		width := (bits.Len64(u) + 7) / 8
+		if width == 0 {
+			width = 1
+		}
+		e.out.WriteByte(byte(rleShortRepeat<<6 | (width-1)<<3 | (e.runCount - minRepeat)))
+		for i := width - 1; i >= 0; i-- {
+			e.out.WriteByte(byte(u >> uint(8*i)))
+		}
+	} else {
+		// a delta of zero
+		e.writeHeader(rleDelta, 0, e.runCount)
+		e.writeFirst(e.runValue)
+		writeVarint(e.out, 0)
+	}
+	e.runCount = 0
+}
+
+// writeHeader writes the 2 byte header of the direct, patched base and delta sub-encodings
+func (e *intRLEv2Writer) writeHeader(encoding, encodedWidth, length int) {
+	length--
+	e.out.WriteByte(byte(encoding<<6 | encodedWidth<<1 | length>>8&0x01))
+	e.out.WriteByte(byte(length))
+}
+
+func (e *intRLEv2Writer) writeLiterals() {
+	values := e.literals
+	if len(values) == 0 {
+		return
+	}
+	defer func() { e.literals = e.literals[:0] }()
+
+	var maxEncoded uint64
+	for _, v := range values {
+		if u := e.encode(v); u > maxEncoded {
+			maxEncoded = u
+		}
+	}
+	directWidth := closestFixedBits(bits.Len64(maxEncoded))
+
+	if len(values) >= 2 {
+		if fixed, deltaWidth, ok := deltas(values); ok {
+			if fixed {
+				e.writeHeader(rleDelta, 0, len(values))
+				e.writeFirst(values[0])
+				writeVarint(e.out, values[1]-values[0])
+				return
+			}
+			if deltaWidth < directWidth {
+				e.writeHeader(rleDelta, encodeBitWidth(deltaWidth), len(values))
+				e.writeFirst(values[0])
+				writeVarint(e.out, values[1]-values[0])
+				packed := make([]uint64, len(values)-2)
+				for i := range packed {
+					d := values[i+2] - values[i+1]
+					if d < 0 {
+						d = -d
+					}
+					packed[i] = uint64(d)
+				}
+				packInts(e.out, packed, deltaWidth)
+				return
+			}
+		}
+	}
+
+	e.writeHeader(rleDirect, encodeBitWidth(directWidth), len(values))
+	packed := make([]uint64, len(values))
+	for i, v := range values {
+		packed[i] = e.encode(v)
+	}
+	packInts(e.out, packed, directWidth)
+}
+
+// deltas reports whether values form a monotonic sequence suitable for the delta sub-encoding, whether every delta
+// is the same, and the bit width needed for the deltas following the first
+func deltas(values []int64) (fixed bool, width int, ok bool) {
+	base, overflow := sub(values[1], values[0])
+	if overflow {
+		return false, 0, false
+	}
+	fixed = true
+	var max uint64
+	for i := 2; i < len(values); i++ {
+		d, overflow := sub(values[i], values[i-1])
+		if overflow || (base >= 0 && d < 0) || (base < 0 && d > 0) {
+			return false, 0, false
+		}
+		if d != base {
+			fixed = false
+		}
+		if d < 0 {
+			d = -d
+		}
+		if uint64(d) > max {
+			max = uint64(d)
+		}
+	}
+	width = closestFixedBits(bits.Len64(max))
+	if width == 1 {
+		// an encoded width of zero marks a fixed delta, so one bit deltas are widened
+		width = 2
+	}
+	return fixed, width, true
+}
+
+// sub returns a - b and whether the difference overflowed, or is the one value whose negation does
+func sub(a, b int64) (int64, bool) {
+	d := a - b
+	overflow := (a >= 0) != (b >= 0) && (d >= 0) != (a >= 0)
+	return d, overflow || d == -1<<63
+}
+
+// packInts writes values big endian bit packed at the given width, padding the final byte with zeros
+func packInts(out *outStream, values []uint64, width int) {
+	var (
+		current byte
+		used    int
+	)
+	for _, v := range values {
+		left := width
+		for left > 0 {
+			take := 8 - used
+			if take > left {
+				take = left
+			}
+			bits := byte(v>>uint(left-take)) & byte(1<<uint(take)-1)
+			current |= bits << uint(8-used-take)
+			used += take
+			left -= take
+			if used == 8 {
+				out.WriteByte(current)
+				current = 0
+				used = 0
+			}
+		}
+	}
+	if used > 0 {
+		out.WriteByte(current)
+	}
+}
+
+// encodeBitWidth is the inverse of decodeBitWidth for widths returned by closestFixedBits
+func encodeBitWidth(n int) int {
+	switch {
+	case n <= 24:
+		return n - 1
+	case n <= 26:
+		return 24
+	case n <= 28:
+		return 25
+	case n <= 30:
+		return 26
+	case n <= 32:
+		return 27
+	case n <= 40:
+		return 28
+	case n <= 48:
+		return 29
+	case n <= 56:
+		return 30
+	default:
+		return 31
+	}
+}
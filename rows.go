@@ -0,0 +1,157 @@
+package orc
+
+import (
+	"context"
+)
+
+// ReadOptions configures how Rows decodes a file
+type ReadOptions struct {
+	// StripeConcurrency is the number of stripes decoded at once. Values below 1 decode one stripe at a time.
+	StripeConcurrency int
+	// ColumnConcurrency is the number of top level columns of each stripe decoded at once. Values below 1 decode
+	// one column at a time.
+	ColumnConcurrency int
+}
+
+type stripeResult struct {
+	batch *Batch
+	err   error
+}
+
+// Rows iterates over the rows of a file in file order, decoding stripes ahead of the caller as configured by
+// ReadOptions.
+//
+// Values are decoded as:
+//
+//	boolean                 bool
+//	tinyint                 int8
+//	smallint                int16
+//	int                     int32
+//	bigint                  int64
+//	float                   float32
+//	double                  float64
+//	string, varchar, char   string
+//	binary                  []byte
+//	timestamp               time.Time, in the writer's timezone
+//	date                    time.Time, midnight UTC
+//	decimal                 Decimal
+//	array                   []interface{}
+//	map                     []MapEntry
+//	struct                  []interface{}, one value per field
+//	uniontype               Union
+//
+// with nil for nulls. Dates and timestamps of files in the hybrid Julian and Gregorian calendar are the days they
+// stand for, unless the file is opened with OpenOptions.RebaseCalendar.
+type Rows struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	pending chan chan stripeResult
+	batch   *Batch
+	row     int
+	closed  bool
+	err     error
+}
+
+// Rows starts decoding the file. Canceling ctx or calling Close stops any decoding in flight. opts may be nil.
+func (f *File) Rows(ctx context.Context, opts *ReadOptions) *Rows {
+	if opts == nil {
+		opts = &ReadOptions{}
+	}
+	stripes := opts.StripeConcurrency
+	if stripes < 1 {
+		stripes = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	r := &Rows{
+		ctx:    ctx,
+		cancel: cancel,
+		// decoded stripes waiting for the caller are bounded along with those in flight
+		pending: make(chan chan stripeResult, stripes),
+	}
+
+	go func() {
+		defer close(r.pending)
+		sem := make(chan struct{}, stripes)
+		for i := range f.Footer.GetStripes() {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			result := make(chan stripeResult, 1)
+			select {
+			case r.pending <- result:
+			case <-ctx.Done():
+				return
+			}
+			go func(i int) {
+				defer func() { <-sem }()
+				batch, err := f.readStripe(ctx, i, opts.ColumnConcurrency)
+				result <- stripeResult{batch, err}
+			}(i)
+		}
+	}()
+
+	return r
+}
+
+// Next advances to the next row, returning false at the end of the file or on error
+func (r *Rows) Next() bool {
+	if r.batch != nil && r.row+1 < r.batch.NumRows {
+		r.row++
+		return true
+	}
+	for r.NextBatch() {
+		if r.batch.NumRows > 0 {
+			r.row = 0
+			return true
+		}
+	}
+	return false
+}
+
+// Row returns the values of the current row, one for each field of the root struct
+func (r *Rows) Row() []interface{} {
+	return r.batch.Row(r.row)
+}
+
+// NextBatch advances to the next stripe, skipping any rows remaining in the current one
+func (r *Rows) NextBatch() bool {
+	r.batch = nil
+	if r.err != nil || r.closed {
+		return false
+	}
+	result, ok := <-r.pending
+	if !ok {
+		r.err = r.ctx.Err()
+		return false
+	}
+	res := <-result
+	if res.err != nil {
+		r.err = res.err
+		r.cancel()
+		return false
+	}
+	r.batch = res.batch
+	r.row = -1
+	return true
+}
+
+// Batch returns the current stripe's rows
+func (r *Rows) Batch() *Batch {
+	return r.batch
+}
+
+// Err returns the error, if any, that ended iteration
+func (r *Rows) Err() error {
+	return r.err
+}
+
+// Close stops decoding. It is safe to call Close more than once.
+func (r *Rows) Close() error {
+	r.closed = true
+	r.batch = nil
+	r.cancel()
+	return nil
+}
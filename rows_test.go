@@ -0,0 +1,92 @@
+package orc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestReadStripe(t *testing.T) {
+	o, err := Open("examples/TestOrcFile.test1.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Close()
+
+	batch, err := o.ReadStripe(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batch.NumRows != 2 {
+		t.Fatalf("got %d rows; want 2", batch.NumRows)
+	}
+
+	want := []interface{}{
+		true, int8(100), int16(2048), int32(65536), int64(9223372036854775807), float32(2), float64(-5),
+		[]byte{}, "bye",
+		[]interface{}{[]interface{}{[]interface{}{int32(1), "bye"}, []interface{}{int32(2), "sigh"}}},
+		[]interface{}{
+			[]interface{}{int32(100000000), "cat"},
+			[]interface{}{int32(-100000), "in"},
+			[]interface{}{int32(1234), "hat"},
+		},
+		[]MapEntry{
+			{"chani", []interface{}{int32(5), "chani"}},
+			{"mauddib", []interface{}{int32(1), "mauddib"}},
+		},
+	}
+	if got := batch.Row(1); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestRowsConcurrency(t *testing.T) {
+	o, err := Open("examples/TestOrcFile.testSeek.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Close()
+
+	read := func(opts *ReadOptions) [][]interface{} {
+		rows := o.Rows(context.Background(), opts)
+		defer rows.Close()
+		var all [][]interface{}
+		for rows.Next() {
+			all = append(all, rows.Row())
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return all
+	}
+
+	sequential := read(nil)
+	if uint64(len(sequential)) != o.Footer.GetNumberOfRows() {
+		t.Fatalf("got %d rows; want %d", len(sequential), o.Footer.GetNumberOfRows())
+	}
+	concurrent := read(&ReadOptions{StripeConcurrency: 4, ColumnConcurrency: 4})
+	if !reflect.DeepEqual(sequential, concurrent) {
+		t.Error("concurrent decoding differs from sequential decoding")
+	}
+}
+
+func TestRowsCancel(t *testing.T) {
+	o, err := Open("examples/TestOrcFile.testSeek.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rows := o.Rows(ctx, &ReadOptions{StripeConcurrency: 2})
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatal(rows.Err())
+	}
+	cancel()
+	for rows.Next() {
+	}
+	if rows.Err() != context.Canceled {
+		t.Errorf("got %v; want %v", rows.Err(), context.Canceled)
+	}
+}
@@ -0,0 +1,589 @@
+package orc
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DEFAULT_DECIMAL_PRECISION and DEFAULT_DECIMAL_SCALE describe Decimal fields without a decimal tag, as Hive's
+	// unqualified decimal type
+	DEFAULT_DECIMAL_PRECISION = 38
+	DEFAULT_DECIMAL_SCALE     = 10
+)
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	decimalType = reflect.TypeOf(Decimal{})
+)
+
+// encoder converts a Go value to the representation the column writers accept
+type encoder func(v reflect.Value) interface{}
+
+// SchemaOf derives the schema of rows holding the fields of v, a struct or a pointer to one, in the flattened
+// pre-order layout of Footer.Types.
+//
+// Exported fields become columns in order of declaration, named after the field unless a tag such as
+// `orc:"name"` names them, and skipped when tagged `orc:"-"`. As in encoding/json, the fields of embedded structs
+// without a name in their tag are promoted to columns of the struct embedding them, those of nil pointers being
+// written as nulls, and a field hides those of the same name promoted from deeper. Fields of the same depth may not
+// share a name. Their types map as:
+//
+//	bool                                        boolean
+//	int8, int16, int32, int64 and int           tinyint, smallint, int, bigint and bigint
+//	uint8, uint16, uint32, uint64 and uint      smallint, int, bigint, bigint and bigint
+//	float32, float64                            float, double
+//	string                                      string
+//	[]byte, [N]byte                             binary
+//	time.Time                                   timestamp
+//	Decimal                                     decimal(38,10)
+//	slices and arrays                           array
+//	maps                                        map
+//	structs                                     struct
+//
+// Pointers map as the type they point to, nil pointers being written as nulls, as are nil slices and maps. A second
+// tag option overrides the type of strings with `char(n)` or `varchar(n)`, of times with `date` and of decimals with
+// `decimal(precision,scale)`, as in `orc:"price,decimal(10,2)"`. The option applies to the elements of slices. Map
+// entries are written in order of their keys when those are booleans, numbers, strings or times, and in the
+// unspecified order of map iteration otherwise.
+func SchemaOf(v interface{}) ([]*Type, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, fmt.Errorf("cannot derive a schema from nil")
+	}
+	types, _, err := schemaOf(t)
+	return types, err
+}
+
+// typeNames holds the names of the kinds of primitive types in Hive's notation
+var typeNames = map[Type_Kind]string{
+	Type_BOOLEAN:           "boolean",
+	Type_BYTE:              "tinyint",
+	Type_SHORT:             "smallint",
+	Type_INT:               "int",
+	Type_LONG:              "bigint",
+	Type_FLOAT:             "float",
+	Type_DOUBLE:            "double",
+	Type_STRING:            "string",
+	Type_BINARY:            "binary",
+	Type_TIMESTAMP:         "timestamp",
+	Type_DATE:              "date",
+	Type_TIMESTAMP_INSTANT: "timestamp with local time zone",
+}
+
+// defaultStringLength is the length of char and varchar types that do not give one, as in the Java library
+const defaultStringLength = 256
+
+// TypeString returns the type of column id of a schema in Hive's notation as the Java library prints it, such as
+// "struct<name:string,tags:array<string>>". Field names other than letters, digits and underscores are quoted with
+// backticks.
+func TypeString(types []*Type, id uint32) string {
+	var b strings.Builder
+	writeTypeString(&b, types, id)
+	return b.String()
+}
+
+func writeTypeString(b *strings.Builder, types []*Type, id uint32) {
+	if int(id) >= len(types) {
+		b.WriteString("unknown")
+		return
+	}
+	t := types[id]
+	if name, ok := typeNames[t.GetKind()]; ok {
+		b.WriteString(name)
+		return
+	}
+	switch t.GetKind() {
+	case Type_DECIMAL:
+		precision, scale := uint32(DEFAULT_DECIMAL_PRECISION), uint32(DEFAULT_DECIMAL_SCALE)
+		if t.Precision != nil {
+			precision, scale = t.GetPrecision(), t.GetScale()
+		}
+		fmt.Fprintf(b, "decimal(%d,%d)", precision, scale)
+		return
+	case Type_CHAR, Type_VARCHAR:
+		length := t.GetMaximumLength()
+		if t.MaximumLength == nil {
+			length = defaultStringLength
+		}
+		fmt.Fprintf(b, "%s(%d)", strings.ToLower(t.GetKind().String()), length)
+		return
+	}
+
+	b.WriteString(map[Type_Kind]string{
+		Type_LIST:   "array",
+		Type_MAP:    "map",
+		Type_STRUCT: "struct",
+		Type_UNION:  "uniontype",
+	}[t.GetKind()])
+	b.WriteByte('<')
+	for i, sub := range t.GetSubtypes() {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if t.GetKind() == Type_STRUCT && i < len(t.GetFieldNames()) {
+			writeFieldName(b, t.GetFieldNames()[i])
+			b.WriteByte(':')
+		}
+		// subtypes follow their parent in pre-order, which also rules out cycles
+		if sub <= id {
+			b.WriteString("unknown")
+			continue
+		}
+		writeTypeString(b, types, sub)
+	}
+	b.WriteByte('>')
+}
+
+// writeFieldName writes a field name, quoted unless it is made of letters, digits and underscores
+func writeFieldName(b *strings.Builder, name string) {
+	plain := name != ""
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_') {
+			plain = false
+		}
+	}
+	if plain {
+		b.WriteString(name)
+		return
+	}
+	b.WriteString("`" + strings.ReplaceAll(name, "`", "``") + "`")
+}
+
+// schemaOf derives the schema of the struct type t along with the encoder of its values into rows
+func schemaOf(t reflect.Type) ([]*Type, encoder, error) {
+	root := t
+	for root.Kind() == reflect.Ptr {
+		root = root.Elem()
+	}
+	if root.Kind() != reflect.Struct || root == timeType || root == decimalType {
+		return nil, nil, fmt.Errorf("cannot derive a schema from %s, which is not a struct", t)
+	}
+	b := &schemaBuilder{visiting: make(map[reflect.Type]bool)}
+	enc, err := b.add(t, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.types, enc, nil
+}
+
+// schemaBuilder appends types in pre-order as it visits Go types
+type schemaBuilder struct {
+	types []*Type
+	// visiting holds the struct types being visited, which may not contain themselves
+	visiting map[reflect.Type]bool
+}
+
+// add appends the types of values of t, the option of its field tag overriding its kind
+func (b *schemaBuilder) add(t reflect.Type, option string) (encoder, error) {
+	switch {
+	case t == timeType:
+		return b.addTime(option)
+	case t == decimalType:
+		return b.addDecimal(option)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem, err := b.add(t.Elem(), option)
+		if err != nil {
+			return nil, err
+		}
+		return func(v reflect.Value) interface{} {
+			if v.IsNil() {
+				return nil
+			}
+			return elem(v.Elem())
+		}, nil
+	case reflect.String:
+		return b.addString(option)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if err := noOption(t, option); err != nil {
+				return nil, err
+			}
+			b.addType(&Type{Kind: Type_BINARY.Enum()})
+			return encodeBytes, nil
+		}
+		return b.addList(t, option)
+	}
+
+	if err := noOption(t, option); err != nil {
+		return nil, err
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		b.addType(&Type{Kind: Type_BOOLEAN.Enum()})
+		return func(v reflect.Value) interface{} { return v.Bool() }, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		kind := map[reflect.Kind]Type_Kind{
+			reflect.Int8:  Type_BYTE,
+			reflect.Int16: Type_SHORT,
+			reflect.Int32: Type_INT,
+		}[t.Kind()]
+		if kind == 0 {
+			kind = Type_LONG
+		}
+		b.addType(&Type{Kind: kind.Enum()})
+		return func(v reflect.Value) interface{} { return v.Int() }, nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		kind := map[reflect.Kind]Type_Kind{
+			reflect.Uint8:  Type_SHORT,
+			reflect.Uint16: Type_INT,
+		}[t.Kind()]
+		if kind == 0 {
+			kind = Type_LONG
+		}
+		b.addType(&Type{Kind: kind.Enum()})
+		// the writer reports values beyond the range of a long
+		return func(v reflect.Value) interface{} { return v.Uint() }, nil
+	case reflect.Float32:
+		b.addType(&Type{Kind: Type_FLOAT.Enum()})
+		return func(v reflect.Value) interface{} { return float32(v.Float()) }, nil
+	case reflect.Float64:
+		b.addType(&Type{Kind: Type_DOUBLE.Enum()})
+		return func(v reflect.Value) interface{} { return v.Float() }, nil
+	case reflect.Map:
+		return b.addMap(t)
+	case reflect.Struct:
+		return b.addStruct(t)
+	}
+	return nil, fmt.Errorf("cannot derive an ORC type for %s", t)
+}
+
+func (b *schemaBuilder) addType(t *Type) uint32 {
+	b.types = append(b.types, t)
+	return uint32(len(b.types) - 1)
+}
+
+func noOption(t reflect.Type, option string) error {
+	if option != "" {
+		return fmt.Errorf("tag option %q does not apply to %s", option, t)
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addTime(option string) (encoder, error) {
+	switch option {
+	case "":
+		b.addType(&Type{Kind: Type_TIMESTAMP.Enum()})
+	case "date":
+		b.addType(&Type{Kind: Type_DATE.Enum()})
+	default:
+		return nil, fmt.Errorf("tag option %q does not apply to %s", option, timeType)
+	}
+	return func(v reflect.Value) interface{} { return v.Interface().(time.Time) }, nil
+}
+
+func (b *schemaBuilder) addDecimal(option string) (encoder, error) {
+	precision, scale := uint32(DEFAULT_DECIMAL_PRECISION), uint32(DEFAULT_DECIMAL_SCALE)
+	if option != "" {
+		params, ok, err := typeParams(option, "decimal", 2)
+		if !ok {
+			return nil, fmt.Errorf("tag option %q does not apply to %s", option, decimalType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		precision, scale = params[0], params[1]
+		if precision < 1 || precision > maxDecimalDigits || scale > precision {
+			return nil, fmt.Errorf("invalid decimal precision %d and scale %d", precision, scale)
+		}
+	}
+	b.addType(&Type{Kind: Type_DECIMAL.Enum(), Precision: &precision, Scale: &scale})
+	return func(v reflect.Value) interface{} { return v.Interface().(Decimal) }, nil
+}
+
+func (b *schemaBuilder) addString(option string) (encoder, error) {
+	t := &Type{Kind: Type_STRING.Enum()}
+	if option != "" {
+		for _, kind := range []Type_Kind{Type_CHAR, Type_VARCHAR} {
+			params, ok, err := typeParams(option, strings.ToLower(kind.String()), 1)
+			if !ok {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			t = &Type{Kind: kind.Enum(), MaximumLength: &params[0]}
+			break
+		}
+		if t.GetKind() == Type_STRING {
+			return nil, fmt.Errorf("tag option %q does not apply to string", option)
+		}
+	}
+	b.addType(t)
+	return func(v reflect.Value) interface{} { return v.String() }, nil
+}
+
+// typeParams parses option as a type name followed by n parenthesized parameters, ok reporting whether it names
+// the type
+func typeParams(option, name string, n int) (params []uint32, ok bool, err error) {
+	if !strings.HasPrefix(option, name+"(") || !strings.HasSuffix(option, ")") {
+		return nil, false, nil
+	}
+	fields := strings.Split(option[len(name)+1:len(option)-1], ",")
+	if len(fields) != n {
+		return nil, true, fmt.Errorf("tag option %q has %d parameters, expected %d", option, len(fields), n)
+	}
+	for _, f := range fields {
+		p, err := strconv.ParseUint(strings.TrimSpace(f), 10, 32)
+		if err != nil {
+			return nil, true, fmt.Errorf("tag option %q: %w", option, err)
+		}
+		params = append(params, uint32(p))
+	}
+	return params, true, nil
+}
+
+func encodeBytes(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Array {
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return b
+	}
+	if v.IsNil() {
+		return nil
+	}
+	return v.Bytes()
+}
+
+func (b *schemaBuilder) addList(t reflect.Type, option string) (encoder, error) {
+	list := &Type{Kind: Type_LIST.Enum()}
+	b.addType(list)
+	list.Subtypes = []uint32{uint32(len(b.types))}
+	elem, err := b.add(t.Elem(), option)
+	if err != nil {
+		return nil, err
+	}
+	return func(v reflect.Value) interface{} {
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = elem(v.Index(i))
+		}
+		return values
+	}, nil
+}
+
+func (b *schemaBuilder) addMap(t reflect.Type) (encoder, error) {
+	m := &Type{Kind: Type_MAP.Enum()}
+	b.addType(m)
+	m.Subtypes = []uint32{uint32(len(b.types))}
+	key, err := b.add(t.Key(), "")
+	if err != nil {
+		return nil, err
+	}
+	m.Subtypes = append(m.Subtypes, uint32(len(b.types)))
+	value, err := b.add(t.Elem(), "")
+	if err != nil {
+		return nil, err
+	}
+	less := keyOrder(t.Key())
+	return func(v reflect.Value) interface{} {
+		if v.IsNil() {
+			return nil
+		}
+		entries := make([]MapEntry, 0, v.Len())
+		if less == nil {
+			for it := v.MapRange(); it.Next(); {
+				entries = append(entries, MapEntry{Key: key(it.Key()), Value: value(it.Value())})
+			}
+			return entries
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+		for _, k := range keys {
+			entries = append(entries, MapEntry{Key: key(k), Value: value(v.MapIndex(k))})
+		}
+		return entries
+	}, nil
+}
+
+// keyOrder returns a function ordering map keys of type t, or nil when they have no order
+func keyOrder(t reflect.Type) func(a, b reflect.Value) bool {
+	if t == timeType {
+		return func(a, b reflect.Value) bool {
+			return a.Interface().(time.Time).Before(b.Interface().(time.Time))
+		}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return func(a, b reflect.Value) bool { return !a.Bool() && b.Bool() }
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return func(a, b reflect.Value) bool { return a.Int() < b.Int() }
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b reflect.Value) bool { return a.Float() < b.Float() }
+	case reflect.String:
+		return func(a, b reflect.Value) bool { return a.String() < b.String() }
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addStruct(t reflect.Type) (encoder, error) {
+	if b.visiting[t] {
+		return nil, fmt.Errorf("cannot derive an ORC type for the recursive type %s", t)
+	}
+	b.visiting[t] = true
+	defer delete(b.visiting, t)
+
+	fields, err := b.structFields(t, nil)
+	if err != nil {
+		return nil, err
+	}
+	fields, err = visibleFields(fields)
+	if err != nil {
+		return nil, err
+	}
+	s := &Type{Kind: Type_STRUCT.Enum()}
+	b.addType(s)
+	encoders := make([]encoder, len(fields))
+	for i, f := range fields {
+		s.FieldNames = append(s.FieldNames, f.name)
+		s.Subtypes = append(s.Subtypes, uint32(len(b.types)))
+		if encoders[i], err = b.add(f.typ, f.option); err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.goName, err)
+		}
+	}
+	return func(v reflect.Value) interface{} {
+		values := make([]interface{}, len(fields))
+		for i, f := range fields {
+			if field, ok := fieldByIndex(v, f.index); ok {
+				values[i] = encoders[i](field)
+			}
+		}
+		return values
+	}, nil
+}
+
+// structField is a field of a struct that becomes a column, possibly promoted from a struct it embeds
+type structField struct {
+	name, option string
+	// goName is the name of the field in Go
+	goName string
+	typ    reflect.Type
+	// index is the sequence of field indexes leading to the field, one for each struct embedding it and its own
+	index []int
+}
+
+// structFields returns the fields of the struct type t in order of declaration, those of embedded structs in place
+// of the struct, index leading to t
+func (b *schemaBuilder) structFields(t reflect.Type, index []int) ([]structField, error) {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("orc")
+		if tag == "-" {
+			continue
+		}
+		name, option := tag, ""
+		if comma := strings.IndexByte(tag, ','); comma >= 0 {
+			name, option = tag[:comma], tag[comma+1:]
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		embedded := f.Type
+		if embedded.Kind() == reflect.Ptr {
+			embedded = embedded.Elem()
+		}
+		if f.Anonymous && name == "" && embedded.Kind() == reflect.Struct && embedded != timeType &&
+			embedded != decimalType {
+			// the exported fields of unexported structs are promoted all the same
+			if b.visiting[embedded] {
+				return nil, fmt.Errorf("cannot derive an ORC type for the recursive type %s", embedded)
+			}
+			b.visiting[embedded] = true
+			promoted, err := b.structFields(embedded, fieldIndex)
+			delete(b.visiting, embedded)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, promoted...)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, structField{name: name, option: option, goName: f.Name, typ: f.Type,
+			index: fieldIndex})
+	}
+	return fields, nil
+}
+
+// visibleFields drops the fields hidden by shallower ones of the same name, failing for those of the same depth
+func visibleFields(fields []structField) ([]structField, error) {
+	depths := make(map[string]int)
+	for _, f := range fields {
+		if depth, ok := depths[f.name]; !ok || len(f.index) < depth {
+			depths[f.name] = len(f.index)
+		}
+	}
+	visible := fields[:0:0]
+	seen := make(map[string]string)
+	for _, f := range fields {
+		if len(f.index) != depths[f.name] {
+			continue
+		}
+		if other, ok := seen[f.name]; ok {
+			return nil, fmt.Errorf("fields %s and %s are both named %s", other, f.goName, f.name)
+		}
+		seen[f.name] = f.goName
+		visible = append(visible, f)
+	}
+	return visible, nil
+}
+
+// fieldByIndex returns the field of the struct v at index, ok being false when it is promoted from a nil pointer
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// TypedWriter writes values of the struct type T, or of a pointer to one, as rows of the schema SchemaOf derives
+// from it. The embedded Writer is available for its other methods.
+type TypedWriter[T any] struct {
+	*Writer
+	encode encoder
+}
+
+// NewTypedWriter writes an ORC file of the schema of T to w. opts may be nil for the defaults.
+func NewTypedWriter[T any](w io.Writer, opts *WriterOptions) (*TypedWriter[T], error) {
+	types, encode, err := schemaOf(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	ow, err := NewWriter(w, types, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedWriter[T]{Writer: ow, encode: encode}, nil
+}
+
+// Write writes v as a single row
+func (w *TypedWriter[T]) Write(v T) error {
+	row, ok := w.encode(reflect.ValueOf(&v).Elem()).([]interface{})
+	if !ok {
+		return fmt.Errorf("cannot write a nil %T", v)
+	}
+	return w.WriteRow(row)
+}
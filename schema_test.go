@@ -0,0 +1,321 @@
+package orc
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type address struct {
+	Street string
+	Zip    *int32 `orc:"zip"`
+}
+
+type event struct {
+	ID       int64             `orc:"id"`
+	Name     string            `orc:"name,varchar(20)"`
+	Code     string            `orc:"code,char(3)"`
+	Price    Decimal           `orc:"price,decimal(10,2)"`
+	Day      time.Time         `orc:"day,date"`
+	At       *time.Time        `orc:"at"`
+	Flags    []bool            `orc:"flags"`
+	Counts   map[string]uint16 `orc:"counts"`
+	Address  address           `orc:"address"`
+	Previous *address          `orc:"previous"`
+	Digest   [4]byte           `orc:"digest"`
+	Ratio    float32
+	Ignored  string `orc:"-"`
+	internal int
+}
+
+func TestSchemaOf(t *testing.T) {
+	u := proto.Uint32
+	want := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2, 3, 4, 5, 6, 7, 9, 12, 15, 18, 19},
+			FieldNames: []string{"id", "name", "code", "price", "day", "at", "flags", "counts", "address", "previous",
+				"digest", "Ratio"}},
+		{Kind: Type_LONG.Enum()},
+		{Kind: Type_VARCHAR.Enum(), MaximumLength: u(20)},
+		{Kind: Type_CHAR.Enum(), MaximumLength: u(3)},
+		{Kind: Type_DECIMAL.Enum(), Precision: u(10), Scale: u(2)},
+		{Kind: Type_DATE.Enum()},
+		{Kind: Type_TIMESTAMP.Enum()},
+		{Kind: Type_LIST.Enum(), Subtypes: []uint32{8}},
+		{Kind: Type_BOOLEAN.Enum()},
+		{Kind: Type_MAP.Enum(), Subtypes: []uint32{10, 11}},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_INT.Enum()},
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{13, 14}, FieldNames: []string{"Street", "zip"}},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_INT.Enum()},
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{16, 17}, FieldNames: []string{"Street", "zip"}},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_INT.Enum()},
+		{Kind: Type_BINARY.Enum()},
+		{Kind: Type_FLOAT.Enum()},
+	}
+	for _, v := range []interface{}{event{}, &event{}} {
+		types, err := SchemaOf(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(types) != len(want) {
+			t.Fatalf("got %d types; want %d", len(types), len(want))
+		}
+		for i := range want {
+			if !proto.Equal(types[i], want[i]) {
+				t.Errorf("type %d: got %v; want %v", i, types[i], want[i])
+			}
+		}
+		if err := checkTypes(types); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+type audit struct {
+	Created time.Time `orc:"created"`
+	By      string    `orc:"by"`
+	ID      string    `orc:"id"`
+}
+
+type names struct {
+	First, Last string
+}
+
+type customer struct {
+	ID int64 `orc:"id"`
+	audit
+	*names
+	Tagged names `orc:"tagged"`
+}
+
+func TestSchemaOfEmbedded(t *testing.T) {
+	// the id of customer hides that of audit
+	want := "struct<id:bigint,created:timestamp,by:string,First:string,Last:string," +
+		"tagged:struct<First:string,Last:string>>"
+	types, err := SchemaOf(customer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := TypeString(types, 0); got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+
+	created := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	var buf bytes.Buffer
+	w, err := NewTypedWriter[customer](&buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []customer{
+		{ID: 1, audit: audit{Created: created, By: "a", ID: "hidden"}, names: &names{First: "Ada", Last: "Lovelace"}},
+		{ID: 2, Tagged: names{First: "Alan"}},
+	} {
+		if err := w.Write(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wantRows := [][]interface{}{
+		{int64(1), created, "a", "Ada", "Lovelace", []interface{}{"", ""}},
+		// the fields of a nil embedded pointer are null
+		{int64(2), time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), "", nil, nil, []interface{}{"Alan", ""}},
+	}
+	rows := readAll(t, openBytes(t, buf.Bytes()))
+	if len(rows) != len(wantRows) {
+		t.Fatalf("read %d rows; want %d", len(rows), len(wantRows))
+	}
+	for i, row := range rows {
+		for c := range wantRows[i] {
+			if !sameValue(row[c], wantRows[i][c]) {
+				t.Errorf("row %d column %d: got %v; want %v", i, c, row[c], wantRows[i][c])
+			}
+		}
+	}
+}
+
+func TestSchemaOfMapOrder(t *testing.T) {
+	type maps struct {
+		Strings map[string]int8
+		Ints    map[int32]bool
+		Times   map[time.Time]string
+	}
+	day := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	_, enc, err := schemaOf(reflect.TypeOf(maps{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := maps{
+		Strings: map[string]int8{"c": 3, "a": 1, "b": 2, "d": 4, "e": 5},
+		Ints:    map[int32]bool{3: true, -1: false, 2: true, 0: false, 10: true},
+		Times:   map[time.Time]string{day.AddDate(0, 0, 2): "c", day: "a", day.AddDate(0, 0, 1): "b"},
+	}
+	want := [][]interface{}{
+		{"a", "b", "c", "d", "e"},
+		{int64(-1), int64(0), int64(2), int64(3), int64(10)},
+		{day, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2)},
+	}
+	// map iteration order varies, so encode a few times
+	for n := 0; n < 10; n++ {
+		row := enc(reflect.ValueOf(v)).([]interface{})
+		for c, keys := range want {
+			entries := row[c].([]MapEntry)
+			for i, key := range keys {
+				if !sameValue(entries[i].Key, key) {
+					t.Fatalf("column %d entry %d: got key %v; want %v", c, i, entries[i].Key, key)
+				}
+			}
+		}
+	}
+}
+
+func TestTypeString(t *testing.T) {
+	want := "struct<boolean:boolean,byte:tinyint,short:smallint,int:int,long:bigint,float:float,double:double," +
+		"string:string,varchar:varchar(10),char:char(3),binary:binary,timestamp:timestamp,date:date," +
+		"decimal:decimal(10,2),list:array<bigint>,map:map<string,double>,struct:struct<inner:int>," +
+		"union:uniontype<int,string>>"
+	if got := TypeString(allTypes(), 0); got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+	if got := TypeString(allTypes(), 17); got != "map<string,double>" {
+		t.Errorf("got %s for the map", got)
+	}
+
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2, 0}, FieldNames: []string{"first name", "a`b", "self"}},
+		{Kind: Type_DECIMAL.Enum()},
+		{Kind: Type_CHAR.Enum()},
+	}
+	want = "struct<`first name`:decimal(38,10),`a``b`:char(256),self:unknown>"
+	if got := TypeString(types, 0); got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+}
+
+func TestSchemaOfErrors(t *testing.T) {
+	type recursive struct {
+		Next *recursive
+	}
+	type embedsItself struct {
+		*embedsItself
+		F int
+	}
+	type a struct{ Name string }
+	type b struct{ Name string }
+	for _, test := range []struct {
+		v    interface{}
+		want string
+	}{
+		{nil, "nil"},
+		{42, "not a struct"},
+		{struct{ F chan int }{}, "cannot derive an ORC type for chan int"},
+		{struct{ F interface{} }{}, "cannot derive an ORC type for interface {}"},
+		{recursive{}, "recursive type"},
+		{embedsItself{}, "recursive type"},
+		{struct {
+			a
+			b
+		}{}, "fields Name and Name are both named Name"},
+		{struct {
+			F int `orc:"f"`
+			G int `orc:"f"`
+		}{}, "fields F and G are both named f"},
+		{struct {
+			F int `orc:"f,date"`
+		}{}, `tag option "date" does not apply to int`},
+		{struct {
+			F Decimal `orc:"f,decimal(10)"`
+		}{}, "has 1 parameters, expected 2"},
+		{struct {
+			F Decimal `orc:"f,decimal(4,5)"`
+		}{}, "invalid decimal precision 4 and scale 5"},
+		{struct {
+			F string `orc:"f,varchar(x)"`
+		}{}, `tag option "varchar(x)"`},
+	} {
+		_, err := SchemaOf(test.v)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%T: got error %v; want %q", test.v, err, test.want)
+		}
+	}
+}
+
+func TestTypedWriter(t *testing.T) {
+	at := time.Date(2020, time.February, 3, 4, 5, 6, 7000, time.UTC)
+	zip := int32(94107)
+	events := []*event{
+		{
+			ID:       1,
+			Name:     "first",
+			Code:     "abc",
+			Price:    Decimal{Value: big.NewInt(12345), Scale: 2},
+			Day:      time.Date(2020, time.February, 3, 0, 0, 0, 0, time.UTC),
+			At:       &at,
+			Flags:    []bool{true, false},
+			Counts:   map[string]uint16{"a": 65535},
+			Address:  address{Street: "Main", Zip: &zip},
+			Previous: &address{Street: "Side"},
+			Digest:   [4]byte{1, 2, 3, 4},
+			Ratio:    0.5,
+			Ignored:  "not written",
+		},
+		{ID: 2},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewTypedWriter[*event](&buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range events {
+		if err := w.Write(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Write(nil); err == nil {
+		t.Error("wrote a nil event")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f := openBytes(t, buf.Bytes())
+	want := [][]interface{}{
+		{int64(1), "first", "abc", Decimal{Value: big.NewInt(12345), Scale: 2},
+			time.Date(2020, time.February, 3, 0, 0, 0, 0, time.UTC), at, []interface{}{true, false},
+			[]MapEntry{{Key: "a", Value: int32(65535)}}, []interface{}{"Main", int32(94107)},
+			[]interface{}{"Side", nil}, []byte{1, 2, 3, 4}, float32(0.5)},
+		{int64(2), "", "", Decimal{Value: big.NewInt(0), Scale: 2}, time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+			nil, nil, nil, []interface{}{"", nil}, nil, []byte{0, 0, 0, 0}, float32(0)},
+	}
+	rows := f.Rows(context.Background(), nil)
+	defer rows.Close()
+	i := 0
+	for ; rows.Next(); i++ {
+		got := rows.Row()
+		for c := range want[i] {
+			if !sameValue(got[c], want[i][c]) {
+				t.Errorf("row %d column %d: got %v; want %v", i, c, got[c], want[i][c])
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(want) {
+		t.Errorf("read %d rows; want %d", i, len(want))
+	}
+
+	if _, err := NewTypedWriter[int](&buf, nil); err == nil {
+		t.Error("created a writer of ints")
+	}
+}
@@ -0,0 +1,220 @@
+package orc
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// Stats is the decoded statistics of a column, holding the values of ColumnStatistics in Go types rather than in
+// the message of the column kind.
+//
+// Min, Max and Sum return nil when unknown, such as for columns of no values, and otherwise values of the type
+// given by the column kind:
+//
+//	BOOLEAN                    bool, Sum being the uint64 count of true values
+//	BYTE, SHORT, INT, LONG     int64, Sum being nil on overflow
+//	FLOAT, DOUBLE              float64
+//	STRING, CHAR, VARCHAR      string, Sum being the int64 total length. Min and Max may only bound the values when
+//	                           the writer truncated long strings.
+//	BINARY                     Sum only, the int64 total length
+//	DATE                       time.Time at midnight UTC
+//	TIMESTAMP                  time.Time in UTC, holding the wall clock time of the writer as if it were UTC for
+//	                           files written since ORC-135
+//	DECIMAL                    Decimal, Sum being nil on overflow
+//
+// Compound kinds only have counts.
+type Stats interface {
+	// Kind is the kind of the column
+	Kind() Type_Kind
+	// NumberOfValues counts the values which are not null
+	NumberOfValues() uint64
+	// HasNull reports whether any value is null
+	HasNull() bool
+	Min() interface{}
+	Max() interface{}
+	Sum() interface{}
+	// Merge adds the statistics of other values of a column of the same kind, such as those of another stripe or
+	// file, to these
+	Merge(other Stats) error
+	// Statistics returns the statistics as a ColumnStatistics message
+	Statistics() *ColumnStatistics
+}
+
+// NewStats decodes the statistics s of a column of type t
+func NewStats(t *Type, s *ColumnStatistics) Stats {
+	return &decodedStats{kind: t.GetKind(), stats: loadStatistics(t, s)}
+}
+
+// ColumnStats returns the decoded statistics of each column over the whole file
+func (f *File) ColumnStats() []Stats {
+	return f.decodeStats(f.Statistics())
+}
+
+// StripeColumnStats returns the decoded statistics of each column of each stripe
+func (f *File) StripeColumnStats() ([][]Stats, error) {
+	stripes, err := f.StripeStatistics()
+	if err != nil {
+		return nil, err
+	}
+	stats := make([][]Stats, len(stripes))
+	for i, stripe := range stripes {
+		stats[i] = f.decodeStats(stripe.GetColStats())
+	}
+	return stats, nil
+}
+
+func (f *File) decodeStats(columns []*ColumnStatistics) []Stats {
+	types := f.Footer.GetTypes()
+	stats := make([]Stats, 0, len(columns))
+	for id, s := range columns {
+		if id >= len(types) {
+			break
+		}
+		stats = append(stats, NewStats(types[id], s))
+	}
+	return stats
+}
+
+// decodedStats implements Stats over the accumulator of the writer, which merges statistics as it does
+type decodedStats struct {
+	kind  Type_Kind
+	stats *columnStats
+}
+
+func (s *decodedStats) Kind() Type_Kind {
+	return s.kind
+}
+
+func (s *decodedStats) NumberOfValues() uint64 {
+	return s.stats.values
+}
+
+func (s *decodedStats) HasNull() bool {
+	return s.stats.hasNull
+}
+
+func (s *decodedStats) Min() interface{} {
+	return s.bound(true)
+}
+
+func (s *decodedStats) Max() interface{} {
+	return s.bound(false)
+}
+
+// bound returns the minimum or the maximum
+func (s *decodedStats) bound(min bool) interface{} {
+	pick := func(a, b interface{}) interface{} {
+		if min {
+			return a
+		}
+		return b
+	}
+	switch t := s.stats.typed.(type) {
+	case *bucketStats:
+		if t.unknown {
+			return nil
+		}
+		// true sorts after false
+		trues, falses := t.trues > 0, s.stats.values > t.trues
+		if !trues && !falses {
+			return nil
+		}
+		return pick(!falses, trues)
+	case *intStats:
+		if t.set {
+			return pick(t.min, t.max)
+		}
+	case *doubleStats:
+		if t.set {
+			return pick(t.min, t.max)
+		}
+	case *stringStats:
+		if t.set && (min || !t.unbounded) {
+			return pick(t.min, t.max)
+		}
+	case *dateStats:
+		if t.set {
+			return pick(dateOfDays(t.min), dateOfDays(t.max))
+		}
+	case *timestampStats:
+		if t.set {
+			return pick(timeOfMillis(t.min), timeOfMillis(t.max))
+		}
+	case *decimalStats:
+		if t.set {
+			return pick(copyDecimal(t.min), copyDecimal(t.max))
+		}
+	}
+	return nil
+}
+
+func (s *decodedStats) Sum() interface{} {
+	switch t := s.stats.typed.(type) {
+	case *bucketStats:
+		if !t.unknown {
+			return t.trues
+		}
+	case *intStats:
+		if !t.overflow {
+			return t.sum
+		}
+	case *doubleStats:
+		if !t.unknownSum {
+			return t.sum
+		}
+	case *stringStats:
+		if !t.unknownSum {
+			return t.sum
+		}
+	case *binaryStats:
+		if !t.unknownSum {
+			return t.sum
+		}
+	case *decimalStats:
+		if !t.overflow {
+			return copyDecimal(t.sum)
+		}
+	}
+	return nil
+}
+
+func (s *decodedStats) Merge(other Stats) error {
+	o, ok := other.(*decodedStats)
+	if !ok {
+		return fmt.Errorf("merging statistics of %T", other)
+	}
+	if reflect.TypeOf(s.stats.typed) != reflect.TypeOf(o.stats.typed) {
+		return fmt.Errorf("merging statistics of %s with %s", o.kind, s.kind)
+	}
+	if a, ok := s.stats.typed.(*timestampStats); ok {
+		if b := o.stats.typed.(*timestampStats); a.set && b.set && a.utc != b.utc {
+			return fmt.Errorf("merging timestamp statistics of wall clock time with those of instants")
+		}
+	}
+	s.stats.merge(o.stats)
+	return nil
+}
+
+func (s *decodedStats) Statistics() *ColumnStatistics {
+	return s.stats.statistics()
+}
+
+// dateOfDays returns midnight UTC of the day days after 1970-01-01
+func dateOfDays(days int64) time.Time {
+	return time.Unix(days*secondsPerDay, 0).UTC()
+}
+
+// timeOfMillis returns the time millis milliseconds after 1970-01-01 UTC
+func timeOfMillis(millis int64) time.Time {
+	return time.Unix(millis/1000, millis%1000*int64(time.Millisecond)).UTC()
+}
+
+// copyDecimal copies d so that callers cannot change the accumulated statistics through its Value
+func copyDecimal(d Decimal) Decimal {
+	if d.Value == nil {
+		return Decimal{Value: new(big.Int), Scale: d.Scale}
+	}
+	return Decimal{Value: new(big.Int).Set(d.Value), Scale: d.Scale}
+}
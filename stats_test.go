@@ -0,0 +1,144 @@
+package orc
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStats(t *testing.T) {
+	const n = 5000
+	f, err := Open(writeAllTypes(t, n, &WriterOptions{StripeSize: 16 * 1024}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	stats := f.ColumnStats()
+	if len(stats) != len(f.Footer.GetTypes()) {
+		t.Fatalf("got statistics of %d columns", len(stats))
+	}
+
+	var values, trues uint64
+	var sum int64
+	for i := 0; i < n; i++ {
+		if i%7 != 3 {
+			values++
+			sum += int64(i * 1000)
+			if i%3 == 0 {
+				trues++
+			}
+		}
+	}
+	min, _ := ParseDecimal("-50")
+	max, _ := ParseDecimal("4998.99")
+	for _, tc := range []struct {
+		column        uint32
+		kind          Type_Kind
+		min, max, sum interface{}
+	}{
+		{1, Type_BOOLEAN, false, true, trues},
+		{4, Type_INT, int64(0), int64((n - 1) * 1000), sum},
+		{7, Type_DOUBLE, float64(0), float64(n-1) / 3, nil},
+		{8, Type_STRING, "a", "c", int64(values)},
+		{11, Type_BINARY, nil, nil, int64(2 * values)},
+		{13, Type_DATE, date(1969, time.December, 1), date(1969, time.December, 28), nil},
+		{14, Type_DECIMAL, min, max, nil},
+	} {
+		s := stats[tc.column]
+		if s.Kind() != tc.kind || s.NumberOfValues() != values || !s.HasNull() {
+			t.Errorf("column %d: got %s of %d values, nulls %t", tc.column, s.Kind(), s.NumberOfValues(), s.HasNull())
+		}
+		if !sameValue(s.Min(), tc.min) || !sameValue(s.Max(), tc.max) {
+			t.Errorf("column %d: got range %v to %v; want %v to %v", tc.column, s.Min(), s.Max(), tc.min, tc.max)
+		}
+		if tc.sum != nil && s.Sum() != tc.sum {
+			t.Errorf("column %d: got sum %v; want %v", tc.column, s.Sum(), tc.sum)
+		}
+	}
+	if root := stats[0]; root.Kind() != Type_STRUCT || root.NumberOfValues() != n || root.Min() != nil {
+		t.Errorf("got root statistics of %s of %d values from %v", root.Kind(), root.NumberOfValues(), root.Min())
+	}
+	if min, max := stats[12].Min().(time.Time), stats[12].Max().(time.Time); !min.Before(max) {
+		t.Errorf("got timestamps from %s to %s", min, max)
+	}
+
+	stripes, err := f.StripeColumnStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stripes) < 2 {
+		t.Fatalf("got %d stripes; want several", len(stripes))
+	}
+	for column, want := range stats {
+		merged := stripes[0][column]
+		for _, stripe := range stripes[1:] {
+			if err := merged.Merge(stripe[column]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := merged.Statistics(); !proto.Equal(got, want.Statistics()) {
+			t.Errorf("column %d: got merged statistics %v; want %v", column, got, want.Statistics())
+		}
+	}
+
+	// merging across files sums them
+	total := f.ColumnStats()
+	for _, column := range []int{4, 8} {
+		if err := total[column].Merge(stats[column]); err != nil {
+			t.Fatal(err)
+		}
+		if total[column].NumberOfValues() != 2*values || total[column].Max() != stats[column].Max() {
+			t.Errorf("column %d: got %d values up to %v", column, total[column].NumberOfValues(), total[column].Max())
+		}
+	}
+	if got := total[4].Sum(); got != 2*sum {
+		t.Errorf("got merged sum %v; want %d", got, 2*sum)
+	}
+	if err := total[4].Merge(stats[5]); err != nil {
+		t.Errorf("merging int with long statistics: %v", err)
+	}
+	if err := total[4].Merge(stats[8]); err == nil {
+		t.Error("got no error merging int with string statistics")
+	}
+}
+
+func TestStatsUnknown(t *testing.T) {
+	s := NewStats(&Type{Kind: Type_LONG.Enum()}, &ColumnStatistics{NumberOfValues: proto.Uint64(2),
+		IntStatistics: &IntegerStatistics{Minimum: proto.Int64(1), Maximum: proto.Int64(5)}})
+	if s.Min() != int64(1) || s.Max() != int64(5) || s.Sum() != nil {
+		t.Errorf("got %v to %v, sum %v; want 1 to 5 of unknown sum", s.Min(), s.Max(), s.Sum())
+	}
+	empty := NewStats(&Type{Kind: Type_STRING.Enum()}, &ColumnStatistics{HasNull: proto.Bool(true)})
+	if empty.Min() != nil || empty.Max() != nil || !empty.HasNull() {
+		t.Errorf("got %v to %v of no values", empty.Min(), empty.Max())
+	}
+	// a string maximum dropped by the writer leaves the minimum
+	bounded := NewStats(&Type{Kind: Type_STRING.Enum()}, &ColumnStatistics{NumberOfValues: proto.Uint64(1),
+		StringStatistics: &StringStatistics{Minimum: proto.String("a")}})
+	if bounded.Min() != "a" || bounded.Max() != nil {
+		t.Errorf("got %v to %v; want a to unknown", bounded.Min(), bounded.Max())
+	}
+
+	// values without the statistics of their kind have no sum, nor bounds
+	values := &ColumnStatistics{NumberOfValues: proto.Uint64(2)}
+	for _, kind := range []Type_Kind{Type_BOOLEAN, Type_DOUBLE, Type_STRING, Type_BINARY} {
+		s := NewStats(&Type{Kind: kind.Enum()}, values)
+		if s.Min() != nil || s.Max() != nil || s.Sum() != nil {
+			t.Errorf("%s: got %v to %v, sum %v; want unknown", kind, s.Min(), s.Max(), s.Sum())
+		}
+		known := NewStats(&Type{Kind: kind.Enum()}, &ColumnStatistics{})
+		if known.Sum() == nil {
+			t.Errorf("%s: got an unknown sum of no values", kind)
+		}
+		if err := known.Merge(s); err != nil {
+			t.Fatal(err)
+		}
+		if known.Sum() != nil {
+			t.Errorf("%s: got sum %v merging an unknown one", kind, known.Sum())
+		}
+		if got := known.Statistics(); NewStats(&Type{Kind: kind.Enum()}, got).Sum() != nil {
+			t.Errorf("%s: got statistics %v of a known sum", kind, got)
+		}
+	}
+}
@@ -0,0 +1,673 @@
+package orc
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxStatisticsString is the longest string minimum or maximum written, in bytes. Longer values are truncated to
+	// a lower and an upper bound, written instead as ORC-203 does.
+	maxStatisticsString = 1024
+	// maxDecimalDigits is the precision beyond which a decimal sum is considered to have overflowed
+	maxDecimalDigits = 38
+)
+
+// columnStats accumulates the statistics of a column's values, mirroring ColumnStatistics
+type columnStats struct {
+	values  uint64
+	hasNull bool
+	// typed holds the statistics specific to the column kind, nil for compound kinds
+	typed typedStats
+}
+
+// typedStats accumulates one of the kind specific statistics messages of ColumnStatistics
+type typedStats interface {
+	// update adds a non-null value, in the representation the column writer encodes
+	update(v interface{})
+	// merge adds the values accumulated by another typedStats of the same kind
+	merge(other typedStats)
+	// fill sets the kind specific message of s, which is written even for no values but not for statistics loaded
+	// without one
+	fill(s *ColumnStatistics)
+	// load sets the statistics to those of the kind specific message of s, which may be missing
+	load(s *ColumnStatistics)
+}
+
+func newColumnStats(t *Type, loc *time.Location) *columnStats {
+	s := &columnStats{}
+	switch t.GetKind() {
+	case Type_BOOLEAN:
+		s.typed = &bucketStats{}
+	case Type_BYTE, Type_SHORT, Type_INT, Type_LONG:
+		s.typed = &intStats{}
+	case Type_FLOAT, Type_DOUBLE:
+		s.typed = &doubleStats{}
+	case Type_STRING, Type_VARCHAR, Type_CHAR:
+		s.typed = &stringStats{}
+	case Type_BINARY:
+		s.typed = &binaryStats{}
+	case Type_TIMESTAMP:
+		s.typed = &timestampStats{loc: loc, utc: true}
+	case Type_DATE:
+		s.typed = &dateStats{}
+	case Type_DECIMAL:
+		s.typed = &decimalStats{}
+	}
+	return s
+}
+
+func (s *columnStats) null() {
+	s.hasNull = true
+}
+
+func (s *columnStats) update(v interface{}) {
+	s.values++
+	if s.typed != nil {
+		s.typed.update(v)
+	}
+}
+
+func (s *columnStats) merge(other *columnStats) {
+	s.values += other.values
+	s.hasNull = s.hasNull || other.hasNull
+	if s.typed != nil && other.typed != nil {
+		s.typed.merge(other.typed)
+	}
+}
+
+// loadStatistics returns the accumulated statistics a ColumnStatistics message of a column of type t describes, so
+// that it can be merged with others
+func loadStatistics(t *Type, s *ColumnStatistics) *columnStats {
+	stats := newColumnStats(t, time.UTC)
+	stats.values = s.GetNumberOfValues()
+	stats.hasNull = s.GetHasNull()
+	if stats.typed != nil {
+		stats.typed.load(s)
+	}
+	return stats
+}
+
+// statistics builds the ColumnStatistics message of the accumulated values
+func (s *columnStats) statistics() *ColumnStatistics {
+	values, hasNull := s.values, s.hasNull
+	stats := &ColumnStatistics{NumberOfValues: &values, HasNull: &hasNull}
+	if s.typed != nil {
+		s.typed.fill(stats)
+	}
+	return stats
+}
+
+// empty returns an accumulator of no values of the same kind as s
+func (s *columnStats) empty() *columnStats {
+	fresh := &columnStats{}
+	switch t := s.typed.(type) {
+	case *bucketStats:
+		fresh.typed = &bucketStats{}
+	case *intStats:
+		fresh.typed = &intStats{}
+	case *doubleStats:
+		fresh.typed = &doubleStats{}
+	case *stringStats:
+		fresh.typed = &stringStats{}
+	case *binaryStats:
+		fresh.typed = &binaryStats{}
+	case *timestampStats:
+		fresh.typed = &timestampStats{loc: t.loc, utc: t.utc}
+	case *dateStats:
+		fresh.typed = &dateStats{}
+	case *decimalStats:
+		fresh.typed = &decimalStats{}
+	}
+	return fresh
+}
+
+type bucketStats struct {
+	trues uint64
+	// unknown is set when the count of true values is not known, as in statistics loaded without one
+	unknown bool
+}
+
+func (s *bucketStats) update(v interface{}) {
+	if v.(bool) {
+		s.trues++
+	}
+}
+
+func (s *bucketStats) merge(other typedStats) {
+	o := other.(*bucketStats)
+	s.trues += o.trues
+	s.unknown = s.unknown || o.unknown
+}
+
+func (s *bucketStats) fill(stats *ColumnStatistics) {
+	if !s.unknown {
+		stats.BucketStatistics = &BucketStatistics{Count: []uint64{s.trues}}
+	}
+}
+
+func (s *bucketStats) load(stats *ColumnStatistics) {
+	count := stats.GetBucketStatistics().GetCount()
+	s.unknown = missingSum(stats, len(count) == 0)
+	if len(count) > 0 {
+		s.trues = count[0]
+	}
+}
+
+type intStats struct {
+	set      bool
+	min, max int64
+	sum      int64
+	overflow bool
+}
+
+func (s *intStats) update(v interface{}) {
+	i := v.(int64)
+	if !s.set || i < s.min {
+		s.min = i
+	}
+	if !s.set || i > s.max {
+		s.max = i
+	}
+	s.set = true
+	s.add(i)
+}
+
+func (s *intStats) add(i int64) {
+	if s.overflow {
+		return
+	}
+	sum := s.sum + i
+	if (i > 0 && sum < s.sum) || (i < 0 && sum > s.sum) {
+		s.overflow = true
+		return
+	}
+	s.sum = sum
+}
+
+func (s *intStats) merge(other typedStats) {
+	o := other.(*intStats)
+	if !o.set {
+		return
+	}
+	if !s.set || o.min < s.min {
+		s.min = o.min
+	}
+	if !s.set || o.max > s.max {
+		s.max = o.max
+	}
+	s.set = true
+	if o.overflow {
+		s.overflow = true
+	}
+	s.add(o.sum)
+}
+
+func (s *intStats) fill(stats *ColumnStatistics) {
+	stats.IntStatistics = &IntegerStatistics{}
+	if s.set {
+		min, max := s.min, s.max
+		stats.IntStatistics.Minimum = &min
+		stats.IntStatistics.Maximum = &max
+	}
+	if !s.overflow {
+		sum := s.sum
+		stats.IntStatistics.Sum = &sum
+	}
+}
+
+func (s *intStats) load(stats *ColumnStatistics) {
+	is := stats.GetIntStatistics()
+	if is == nil {
+		is = &IntegerStatistics{}
+	}
+	s.set = is.Minimum != nil && is.Maximum != nil
+	s.min, s.max = is.GetMinimum(), is.GetMaximum()
+	s.sum = is.GetSum()
+	s.overflow = is.Sum == nil
+}
+
+type doubleStats struct {
+	set      bool
+	min, max float64
+	sum      float64
+	// unknownSum is set when the sum is not known, as in statistics loaded without one
+	unknownSum bool
+}
+
+func (s *doubleStats) update(v interface{}) {
+	f := v.(float64)
+	s.sum += f
+	if math.IsNaN(f) {
+		return
+	}
+	if !s.set || f < s.min {
+		s.min = f
+	}
+	if !s.set || f > s.max {
+		s.max = f
+	}
+	s.set = true
+}
+
+func (s *doubleStats) merge(other typedStats) {
+	o := other.(*doubleStats)
+	s.sum += o.sum
+	s.unknownSum = s.unknownSum || o.unknownSum
+	if !o.set {
+		return
+	}
+	if !s.set || o.min < s.min {
+		s.min = o.min
+	}
+	if !s.set || o.max > s.max {
+		s.max = o.max
+	}
+	s.set = true
+}
+
+func (s *doubleStats) fill(stats *ColumnStatistics) {
+	stats.DoubleStatistics = &DoubleStatistics{}
+	if !s.unknownSum {
+		sum := s.sum
+		stats.DoubleStatistics.Sum = &sum
+	}
+	if s.set {
+		min, max := s.min, s.max
+		stats.DoubleStatistics.Minimum = &min
+		stats.DoubleStatistics.Maximum = &max
+	}
+}
+
+func (s *doubleStats) load(stats *ColumnStatistics) {
+	ds := stats.GetDoubleStatistics()
+	if ds == nil {
+		ds = &DoubleStatistics{}
+	}
+	s.set = ds.Minimum != nil && ds.Maximum != nil
+	s.min, s.max = ds.GetMinimum(), ds.GetMaximum()
+	s.sum, s.unknownSum = ds.GetSum(), missingSum(stats, ds.Sum == nil)
+}
+
+// stringStats keeps the full minimum and maximum, truncating them only when statistics are built so that merging
+// stays exact
+type stringStats struct {
+	set      bool
+	min, max string
+	sum      int64
+	// minBound and maxBound are set when min and max only bound the values, as in statistics loaded with bounds
+	minBound, maxBound bool
+	// unbounded is set when the maximum is unknown, as in statistics loaded without one
+	unbounded bool
+	// unknownSum is set when the total length is not known, as in statistics loaded without one
+	unknownSum bool
+}
+
+func (s *stringStats) update(v interface{}) {
+	str := v.(string)
+	if !s.set || str < s.min {
+		s.min, s.minBound = str, false
+	}
+	if !s.set || str > s.max {
+		s.max, s.maxBound = str, false
+	}
+	s.set = true
+	s.sum += int64(len(str))
+}
+
+func (s *stringStats) merge(other typedStats) {
+	o := other.(*stringStats)
+	s.sum += o.sum
+	s.unknownSum = s.unknownSum || o.unknownSum
+	if !o.set {
+		return
+	}
+	s.unbounded = s.unbounded || o.unbounded
+	if !s.set || o.min < s.min {
+		s.min, s.minBound = o.min, o.minBound
+	}
+	if !s.set || o.max > s.max {
+		s.max, s.maxBound = o.max, o.maxBound
+	}
+	s.set = true
+}
+
+func (s *stringStats) fill(stats *ColumnStatistics) {
+	stats.StringStatistics = &StringStatistics{}
+	if !s.set {
+		return
+	}
+	ss := stats.StringStatistics
+	if !s.unknownSum {
+		sum := s.sum
+		ss.Sum = &sum
+	}
+	// long values are truncated to bounds, which still hold every value of the column for predicate pushdown
+	min := truncateLowerBound(s.min, maxStatisticsString)
+	if s.minBound || len(min) < len(s.min) {
+		ss.LowerBound = &min
+	} else {
+		ss.Minimum = &min
+	}
+	if max, ok := truncateUpperBound(s.max, maxStatisticsString); ok && !s.unbounded {
+		if s.maxBound || max != s.max {
+			ss.UpperBound = &max
+		} else {
+			ss.Maximum = &max
+		}
+	}
+}
+
+func (s *stringStats) load(stats *ColumnStatistics) {
+	ss := stats.GetStringStatistics()
+	if ss == nil {
+		ss = &StringStatistics{}
+	}
+	// a missing minimum is bounded by the empty string
+	s.set = ss.Minimum != nil || ss.Maximum != nil || ss.LowerBound != nil || ss.UpperBound != nil
+	s.min, s.minBound = ss.GetMinimum(), ss.Minimum == nil && ss.LowerBound != nil
+	if s.minBound {
+		s.min = ss.GetLowerBound()
+	}
+	s.max, s.maxBound = ss.GetMaximum(), ss.Maximum == nil && ss.UpperBound != nil
+	if s.maxBound {
+		s.max = ss.GetUpperBound()
+	}
+	s.unbounded = s.set && ss.Maximum == nil && ss.UpperBound == nil
+	s.sum, s.unknownSum = ss.GetSum(), missingSum(stats, ss.Sum == nil)
+}
+
+// missingSum reports whether statistics lacking a sum, or the count of true values, lack a value which is known
+// otherwise, those of no values summing to zero
+func missingSum(stats *ColumnStatistics, lacking bool) bool {
+	return lacking && stats.GetNumberOfValues() > 0
+}
+
+// truncateLowerBound returns the longest prefix of s of at most n bytes ending on a character boundary, which
+// sorts no later than s
+func truncateLowerBound(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// truncateUpperBound returns a string of at most n bytes that sorts no earlier than s, incrementing the last
+// character of a truncated prefix. ok is false when no such string exists.
+func truncateUpperBound(s string, n int) (string, bool) {
+	if len(s) <= n {
+		return s, true
+	}
+	runes := []rune(truncateLowerBound(s, n))
+	for i := len(runes) - 1; i >= 0; i-- {
+		r := runes[i] + 1
+		if r >= 0xd800 && r <= 0xdfff {
+			// skip the surrogate range, which is not valid in UTF-8
+			r = 0xe000
+		}
+		if r <= utf8.MaxRune {
+			runes[i] = r
+			if bound := string(runes[:i+1]); len(bound) <= n {
+				return bound, true
+			}
+		}
+	}
+	return "", false
+}
+
+type binaryStats struct {
+	sum int64
+	// unknownSum is set when the total length is not known, as in statistics loaded without one
+	unknownSum bool
+}
+
+func (s *binaryStats) update(v interface{}) {
+	s.sum += int64(len(v.([]byte)))
+}
+
+func (s *binaryStats) merge(other typedStats) {
+	o := other.(*binaryStats)
+	s.sum += o.sum
+	s.unknownSum = s.unknownSum || o.unknownSum
+}
+
+func (s *binaryStats) fill(stats *ColumnStatistics) {
+	stats.BinaryStatistics = &BinaryStatistics{}
+	if !s.unknownSum {
+		sum := s.sum
+		stats.BinaryStatistics.Sum = &sum
+	}
+}
+
+func (s *binaryStats) load(stats *ColumnStatistics) {
+	bs := stats.GetBinaryStatistics()
+	s.sum, s.unknownSum = bs.GetSum(), missingSum(stats, bs == nil || bs.Sum == nil)
+}
+
+type dateStats struct {
+	set      bool
+	min, max int64
+}
+
+func (s *dateStats) update(v interface{}) {
+	days := v.(int64)
+	if !s.set || days < s.min {
+		s.min = days
+	}
+	if !s.set || days > s.max {
+		s.max = days
+	}
+	s.set = true
+}
+
+func (s *dateStats) merge(other typedStats) {
+	o := other.(*dateStats)
+	if !o.set {
+		return
+	}
+	if !s.set || o.min < s.min {
+		s.min = o.min
+	}
+	if !s.set || o.max > s.max {
+		s.max = o.max
+	}
+	s.set = true
+}
+
+func (s *dateStats) fill(stats *ColumnStatistics) {
+	stats.DateStatistics = &DateStatistics{}
+	if s.set {
+		min, max := int32(s.min), int32(s.max)
+		stats.DateStatistics.Minimum = &min
+		stats.DateStatistics.Maximum = &max
+	}
+}
+
+func (s *dateStats) load(stats *ColumnStatistics) {
+	ds := stats.GetDateStatistics()
+	if ds == nil {
+		ds = &DateStatistics{}
+	}
+	s.set = ds.Minimum != nil && ds.Maximum != nil
+	s.min, s.max = int64(ds.GetMinimum()), int64(ds.GetMaximum())
+}
+
+// timestampStats tracks milliseconds since the epoch of the wall clock time of values in loc, the writer's timezone,
+// as writers since ORC-135 store them
+type timestampStats struct {
+	loc      *time.Location
+	set      bool
+	min, max int64
+	// utc is set when min and max are of wall clock time, stored in the UTC fields since ORC-135, rather than of the
+	// instants earlier writers stored. Merged files share a writer version, so never mix the two.
+	utc bool
+}
+
+func (s *timestampStats) update(v interface{}) {
+	millis := localMillis(v.(time.Time), s.loc)
+	if !s.set || millis < s.min {
+		s.min = millis
+	}
+	if !s.set || millis > s.max {
+		s.max = millis
+	}
+	s.set = true
+}
+
+// localMillis returns the milliseconds since the epoch of the wall clock time of t in loc
+func localMillis(t time.Time, loc *time.Location) int64 {
+	t = t.In(loc)
+	_, offset := t.Zone()
+	return (t.Unix()+int64(offset))*1000 + int64(t.Nanosecond())/1e6
+}
+
+func (s *timestampStats) merge(other typedStats) {
+	o := other.(*timestampStats)
+	if !o.set {
+		return
+	}
+	if !s.set {
+		s.utc = o.utc
+	}
+	if !s.set || o.min < s.min {
+		s.min = o.min
+	}
+	if !s.set || o.max > s.max {
+		s.max = o.max
+	}
+	s.set = true
+}
+
+func (s *timestampStats) fill(stats *ColumnStatistics) {
+	stats.TimestampStatistics = &TimestampStatistics{}
+	if s.set && s.utc {
+		min, max := s.min, s.max
+		stats.TimestampStatistics.MinimumUtc = &min
+		stats.TimestampStatistics.MaximumUtc = &max
+	} else if s.set {
+		min, max := s.min, s.max
+		stats.TimestampStatistics.Minimum = &min
+		stats.TimestampStatistics.Maximum = &max
+	}
+}
+
+func (s *timestampStats) load(stats *ColumnStatistics) {
+	ts := stats.GetTimestampStatistics()
+	if ts == nil {
+		ts = &TimestampStatistics{}
+	}
+	s.utc = ts.MinimumUtc != nil && ts.MaximumUtc != nil
+	if s.utc {
+		s.set = true
+		s.min, s.max = ts.GetMinimumUtc(), ts.GetMaximumUtc()
+		return
+	}
+	s.set = ts.Minimum != nil && ts.Maximum != nil
+	s.min, s.max = ts.GetMinimum(), ts.GetMaximum()
+}
+
+type decimalStats struct {
+	set      bool
+	min, max Decimal
+	sum      Decimal
+	overflow bool
+}
+
+func (s *decimalStats) update(v interface{}) {
+	d := v.(Decimal)
+	if !s.set || compareDecimal(d, s.min) < 0 {
+		// copied, as the caller keeps d.Value
+		s.min = Decimal{Value: new(big.Int).Set(d.Value), Scale: d.Scale}
+	}
+	if !s.set || compareDecimal(d, s.max) > 0 {
+		s.max = Decimal{Value: new(big.Int).Set(d.Value), Scale: d.Scale}
+	}
+	s.set = true
+	s.add(d)
+}
+
+func (s *decimalStats) add(d Decimal) {
+	if s.overflow {
+		return
+	}
+	scale := s.sum.Scale
+	if d.Scale > scale {
+		scale = d.Scale
+	}
+	a, b := s.sum.Rescale(scale), d.Rescale(scale)
+	sum := Decimal{Value: new(big.Int).Add(a.Value, b.Value), Scale: scale}
+	if len(new(big.Int).Abs(sum.Value).String()) > maxDecimalDigits {
+		s.overflow = true
+		return
+	}
+	s.sum = sum
+}
+
+func (s *decimalStats) merge(other typedStats) {
+	o := other.(*decimalStats)
+	if !o.set {
+		return
+	}
+	if !s.set || compareDecimal(o.min, s.min) < 0 {
+		s.min = o.min
+	}
+	if !s.set || compareDecimal(o.max, s.max) > 0 {
+		s.max = o.max
+	}
+	s.set = true
+	if o.overflow {
+		s.overflow = true
+	}
+	s.add(o.sum)
+}
+
+func (s *decimalStats) fill(stats *ColumnStatistics) {
+	stats.DecimalStatistics = &DecimalStatistics{}
+	if s.set {
+		min, max := decimalString(s.min), decimalString(s.max)
+		stats.DecimalStatistics.Minimum = &min
+		stats.DecimalStatistics.Maximum = &max
+	}
+	if !s.overflow {
+		sum := decimalString(s.sum)
+		stats.DecimalStatistics.Sum = &sum
+	}
+}
+
+func (s *decimalStats) load(stats *ColumnStatistics) {
+	ds := stats.GetDecimalStatistics()
+	if ds == nil {
+		ds = &DecimalStatistics{}
+	}
+	min, minErr := ParseDecimal(ds.GetMinimum())
+	max, maxErr := ParseDecimal(ds.GetMaximum())
+	s.set = minErr == nil && maxErr == nil
+	s.min, s.max = min, max
+	sum, err := ParseDecimal(ds.GetSum())
+	s.sum = sum
+	s.overflow = err != nil
+}
+
+// decimalString formats d without trailing fractional zeros, as Java writers do
+func decimalString(d Decimal) string {
+	str := d.String()
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+	return str
+}
+
+// compareDecimal compares two decimals of any scale, returning -1, 0 or 1
+func compareDecimal(a, b Decimal) int {
+	scale := a.Scale
+	if b.Scale > scale {
+		scale = b.Scale
+	}
+	return a.Rescale(scale).Value.Cmp(b.Rescale(scale).Value)
+}
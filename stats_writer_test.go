@@ -0,0 +1,207 @@
+package orc
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TestStatisticsMatchJava rewrites a file written by the Java implementation and compares the file statistics
+func TestStatisticsMatchJava(t *testing.T) {
+	f, err := Open("examples/TestOrcFile.test1.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w, err := NewWriter(&bytes.Buffer{}, f.Footer.GetTypes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := f.Rows(context.Background(), nil)
+	defer rows.Close()
+	for rows.Next() {
+		if err := w.WriteRow(rows.Row()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range f.Footer.GetStatistics() {
+		if got := w.fileStats[i].statistics(); !proto.Equal(got, want) {
+			t.Errorf("column %d: got %v; want %v", i, got, want)
+		}
+	}
+}
+
+func TestStripeStatistics(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1}, FieldNames: []string{"x"}},
+		{Kind: Type_LONG.Enum()},
+	}
+	w, err := NewWriter(&bytes.Buffer{}, types, &WriterOptions{StripeSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5000; i++ {
+		var v interface{} = int64(i)
+		if i%10 == 0 {
+			v = nil
+		}
+		if err := w.WriteRow([]interface{}{v}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(w.stripeStats) < 2 {
+		t.Fatalf("got %d stripes; want several", len(w.stripeStats))
+	}
+
+	var values uint64
+	var sum int64
+	min, max := int64(math.MaxInt64), int64(math.MinInt64)
+	for _, stripe := range w.stripeStats {
+		s := stripe.GetColStats()[1]
+		if !s.GetHasNull() {
+			t.Error("stripe statistics missing nulls")
+		}
+		values += s.GetNumberOfValues()
+		sum += s.GetIntStatistics().GetSum()
+		if m := s.GetIntStatistics().GetMinimum(); m < min {
+			min = m
+		}
+		if m := s.GetIntStatistics().GetMaximum(); m > max {
+			max = m
+		}
+	}
+	file := w.fileStats[1].statistics()
+	if values != 4500 || file.GetNumberOfValues() != values {
+		t.Errorf("got %d values in stripes, %d in file; want 4500", values, file.GetNumberOfValues())
+	}
+	if file.GetIntStatistics().GetSum() != sum || min != 1 || max != 4999 {
+		t.Errorf("got file %v; stripes sum %d min %d max %d", file, sum, min, max)
+	}
+}
+
+func TestIntStatisticsOverflow(t *testing.T) {
+	s := &intStats{}
+	s.update(int64(math.MaxInt64))
+	s.update(int64(1))
+	stats := &ColumnStatistics{}
+	s.fill(stats)
+	if stats.GetIntStatistics().Sum != nil {
+		t.Errorf("got sum %d; want none after overflow", stats.GetIntStatistics().GetSum())
+	}
+	if stats.GetIntStatistics().GetMaximum() != math.MaxInt64 || stats.GetIntStatistics().GetMinimum() != 1 {
+		t.Errorf("got %v", stats.GetIntStatistics())
+	}
+}
+
+func TestDecimalStatisticsOverflow(t *testing.T) {
+	// 37 digits, ten of which sum to 38 digits
+	large, _ := new(big.Int).SetString(strings.Repeat("9", 37), 10)
+	s := &decimalStats{}
+	for i := 0; i < 10; i++ {
+		s.update(Decimal{Value: large, Scale: 2})
+	}
+	stats := &ColumnStatistics{}
+	s.fill(stats)
+	if want := strings.Repeat("9", 36) + ".9"; stats.GetDecimalStatistics().GetSum() != want {
+		t.Errorf("got sum %s; want %s", stats.GetDecimalStatistics().GetSum(), want)
+	}
+
+	s.update(Decimal{Value: large, Scale: 2})
+	s.fill(stats)
+	if stats.GetDecimalStatistics().Sum != nil {
+		t.Errorf("got sum %s; want none after overflow", stats.GetDecimalStatistics().GetSum())
+	}
+	if want := strings.Repeat("9", 35) + ".99"; stats.GetDecimalStatistics().GetMaximum() != want {
+		t.Errorf("got maximum %s; want %s", stats.GetDecimalStatistics().GetMaximum(), want)
+	}
+}
+
+func TestStringStatisticsTruncation(t *testing.T) {
+	long := strings.Repeat("a", maxStatisticsString-1) + "é" + "z"
+	s := &stringStats{}
+	s.update(long)
+	s.update("b")
+	stats := &ColumnStatistics{}
+	s.fill(stats)
+	if got, want := stats.GetStringStatistics().GetLowerBound(), strings.Repeat("a", maxStatisticsString-1); got != want ||
+		stats.GetStringStatistics().Minimum != nil {
+		t.Errorf("got lower bound of %d bytes and minimum %v; want a lower bound of %d bytes", len(got),
+			stats.GetStringStatistics().Minimum != nil, len(want))
+	}
+	if got := stats.GetStringStatistics().GetMaximum(); got != "b" {
+		t.Errorf("got maximum %q; want %q", got, "b")
+	}
+	if got := stats.GetStringStatistics().GetSum(); got != int64(len(long)+1) {
+		t.Errorf("got sum %d; want %d", got, len(long)+1)
+	}
+
+	// bounds survive loading and merging
+	loaded := loadStatistics(&Type{Kind: Type_STRING.Enum()}, stats)
+	loaded.update("c")
+	merged := loaded.statistics().GetStringStatistics()
+	if merged.GetLowerBound() != stats.GetStringStatistics().GetLowerBound() || merged.Minimum != nil ||
+		merged.GetMaximum() != "c" || merged.UpperBound != nil {
+		t.Errorf("got merged statistics %v", merged)
+	}
+	upper := &ColumnStatistics{
+		NumberOfValues:   proto.Uint64(0),
+		HasNull:          proto.Bool(false),
+		StringStatistics: &StringStatistics{Minimum: proto.String("a"), UpperBound: proto.String("b"), Sum: proto.Int64(3)},
+	}
+	if got := loadStatistics(&Type{Kind: Type_STRING.Enum()}, upper).statistics(); !proto.Equal(got, upper) {
+		t.Errorf("got %v; want %v", got, upper)
+	}
+
+	for _, test := range []struct {
+		in   string
+		n    int
+		want string
+		ok   bool
+	}{
+		{"short", 5, "short", true},
+		{"abcd", 3, "abd", true},
+		{"ab\U0010ffffz", 6, "ac", true},
+		{"a\ud7ffz", 4, "a\ue000", true},
+		{"\U0010ffff\U0010ffffz", 8, "", false},
+	} {
+		got, ok := truncateUpperBound(test.in, test.n)
+		if got != test.want || ok != test.ok {
+			t.Errorf("truncateUpperBound(%q, %d) = %q, %v; want %q, %v", test.in, test.n, got, ok, test.want, test.ok)
+		}
+		if ok && got < test.in {
+			t.Errorf("truncateUpperBound(%q, %d) = %q sorts before the input", test.in, test.n, got)
+		}
+	}
+}
+
+func TestTimestampStatisticsUtc(t *testing.T) {
+	timestamp := &Type{Kind: Type_TIMESTAMP.Enum()}
+	for _, want := range []*TimestampStatistics{
+		{Minimum: proto.Int64(1000), Maximum: proto.Int64(2000)},
+		// as written since ORC-135
+		{MinimumUtc: proto.Int64(1000), MaximumUtc: proto.Int64(2000)},
+	} {
+		stats := &ColumnStatistics{NumberOfValues: proto.Uint64(2), HasNull: proto.Bool(false), TimestampStatistics: want}
+		loaded := loadStatistics(timestamp, stats)
+		loaded.merge(loadStatistics(timestamp, stats))
+		if got := loaded.statistics().GetTimestampStatistics(); !proto.Equal(got, want) {
+			t.Errorf("got %v; want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package orc
+
+import (
+	"bytes"
+)
+
+// outStream buffers a single stream of a stripe, compressing each chunk once it is full so that positions within
+// the stream can be recorded as values are written
+type outStream struct {
+	// compress compresses a single chunk and adds its header, nil when streams are not compressed
+	compress  func([]byte) []byte
+	blockSize int
+	out       bytes.Buffer
+	// chunk holds the uncompressed bytes following those in out
+	chunk []byte
+}
+
+func (w *Writer) newStream() *outStream {
+	s := &outStream{blockSize: w.opts.CompressionBlockSize}
+	if w.opts.Compression != CompressionKind_NONE {
+		s.compress = w.compress
+	}
+	return s
+}
+
+func (s *outStream) Write(p []byte) (int, error) {
+	if s.compress == nil {
+		return s.out.Write(p)
+	}
+	n := len(p)
+	for len(p) > 0 {
+		if len(s.chunk) == s.blockSize {
+			s.spill()
+		}
+		take := s.blockSize - len(s.chunk)
+		if take > len(p) {
+			take = len(p)
+		}
+		s.chunk = append(s.chunk, p[:take]...)
+		p = p[take:]
+	}
+	return n, nil
+}
+
+func (s *outStream) WriteString(str string) (int, error) {
+	if s.compress == nil {
+		return s.out.WriteString(str)
+	}
+	n := len(str)
+	for len(str) > 0 {
+		if len(s.chunk) == s.blockSize {
+			s.spill()
+		}
+		take := s.blockSize - len(s.chunk)
+		if take > len(str) {
+			take = len(str)
+		}
+		s.chunk = append(s.chunk, str[:take]...)
+		str = str[take:]
+	}
+	return n, nil
+}
+
+func (s *outStream) WriteByte(b byte) error {
+	if s.compress == nil {
+		return s.out.WriteByte(b)
+	}
+	if len(s.chunk) == s.blockSize {
+		s.spill()
+	}
+	s.chunk = append(s.chunk, b)
+	return nil
+}
+
+func (s *outStream) spill() {
+	s.out.Write(s.compress(s.chunk))
+	s.chunk = s.chunk[:0]
+}
+
+// position appends the position of the next byte written: the offset of its chunk in the compressed stream
+// followed by its offset in the uncompressed chunk, or only its offset when the stream is not compressed
+func (s *outStream) position(positions []uint64) []uint64 {
+	if s.compress == nil {
+		return append(positions, uint64(s.out.Len()))
+	}
+	return append(positions, uint64(s.out.Len()), uint64(len(s.chunk)))
+}
+
+// Len returns the number of bytes buffered, compressed or not
+func (s *outStream) Len() int {
+	return s.out.Len() + len(s.chunk)
+}
+
+// finish compresses any partial chunk and returns the stream, which is valid until the next call to Reset
+func (s *outStream) finish() []byte {
+	if len(s.chunk) > 0 {
+		s.spill()
+	}
+	return s.out.Bytes()
+}
+
+func (s *outStream) Reset() {
+	s.out.Reset()
+	s.chunk = s.chunk[:0]
+}
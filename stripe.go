@@ -0,0 +1,258 @@
+package orc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+// rowsPerCheck is the number of rows decoded between checks for cancellation
+const rowsPerCheck = 1024
+
+type streamKey struct {
+	column uint32
+	kind   Stream_Kind
+}
+
+// stripe holds the decompressed data streams of a single stripe
+type stripe struct {
+	footer  *StripeFooter
+	streams map[streamKey][]byte
+	loc     *time.Location
+}
+
+// loadStripe reads, decrypts and decompresses all data streams of stripe i. Index streams are skipped.
+func (f *File) loadStripe(i int) (*stripe, error) {
+	info := f.Footer.GetStripes()[i]
+	footer, err := f.GetStripeFooter(info)
+	if err != nil {
+		return nil, err
+	}
+	streams, err := f.stripeStreams(i, footer)
+	if err != nil {
+		return nil, err
+	}
+
+	length := info.GetIndexLength() + info.GetDataLength()
+	buf, err := f.readSection("stripe", int64(info.GetOffset()), int64(length))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &stripe{footer: footer, streams: make(map[streamKey][]byte), loc: time.UTC}
+	for _, stream := range streams {
+		end := stream.offset + stream.GetLength()
+		if end > length || end < stream.offset {
+			return nil, corrupt("stripe", int64(info.GetOffset()),
+				fmt.Errorf("stream %s of column %d extends past end of stripe", stream.GetKind(), stream.GetColumn()))
+		}
+		if isIndexStream(stream.GetKind()) {
+			continue
+		}
+		streamOffset := int64(info.GetOffset() + stream.offset)
+		data, err := f.decryptStream(i, stream, buf[stream.offset:end])
+		if err != nil {
+			return nil, err
+		}
+		if f.PostScript.GetCompression() != CompressionKind_NONE {
+			if data, err = ioutil.ReadAll(f.compressedReader(bytes.NewReader(data))); err != nil {
+				return nil, corrupt("stream", streamOffset,
+					fmt.Errorf("while decompressing stream %s of column %d: %w", stream.GetKind(), stream.GetColumn(), err))
+			}
+		}
+		s.streams[streamKey{stream.GetColumn(), stream.GetKind()}] = data
+	}
+
+	if s.loc, err = writerLocation(footer); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// writerLocation returns the timezone of the writer of a stripe, UTC when it is not recorded
+func writerLocation(footer *StripeFooter) (*time.Location, error) {
+	tz := footer.GetWriterTimezone()
+	if tz == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, &UnsupportedError{Feature: "writer timezone " + tz}
+	}
+	return loc, nil
+}
+
+// stream returns a reader over a stream, empty when the writer omitted it
+func (s *stripe) stream(column uint32, kind Stream_Kind) *bytes.Reader {
+	return bytes.NewReader(s.streams[streamKey{column, kind}])
+}
+
+func (s *stripe) encoding(column uint32) ColumnEncoding_Kind {
+	columns := s.footer.GetColumns()
+	if int(column) >= len(columns) {
+		return ColumnEncoding_DIRECT
+	}
+	return columns[column].GetKind()
+}
+
+func (s *stripe) presence(column uint32) presence {
+	data, ok := s.streams[streamKey{column, Stream_PRESENT}]
+	if !ok {
+		return presence{}
+	}
+	return presence{newBoolRLE(bytes.NewReader(data))}
+}
+
+// dictionary decodes the dictionary of a dictionary encoded string column
+func (s *stripe) dictionary(column uint32) ([][]byte, error) {
+	var size uint32
+	if columns := s.footer.GetColumns(); int(column) < len(columns) {
+		size = columns[column].GetDictionarySize()
+	}
+	data := s.streams[streamKey{column, Stream_DICTIONARY_DATA}]
+	lengths := newIntDecoder(s.stream(column, Stream_LENGTH), s.encoding(column), false)
+
+	dictionary := make([][]byte, size)
+	var offset uint64
+	for i := range dictionary {
+		length, err := lengths.next()
+		if err != nil {
+			return nil, fmt.Errorf("while reading dictionary lengths of column %d: %w", column, unexpected(err))
+		}
+		end := offset + uint64(length)
+		if end > uint64(len(data)) || end < offset {
+			return nil, fmt.Errorf("dictionary of column %d shorter than its lengths", column)
+		}
+		dictionary[i] = data[offset:end:end]
+		offset = end
+	}
+	return dictionary, nil
+}
+
+// Batch holds the decoded rows of a single stripe, column by column
+type Batch struct {
+	// Stripe is the index of the stripe within the file
+	Stripe int
+	// NumRows is the number of rows in the batch
+	NumRows int
+	// Columns holds NumRows values for each field of the root struct, or a single column when the root type is
+	// not a struct
+	Columns [][]interface{}
+}
+
+// Row returns the values of row i of the batch
+func (b *Batch) Row(i int) []interface{} {
+	row := make([]interface{}, len(b.Columns))
+	for c := range b.Columns {
+		row[c] = b.Columns[c][i]
+	}
+	return row
+}
+
+// ReadStripe decodes every row of stripe i
+func (f *File) ReadStripe(i int) (*Batch, error) {
+	return f.readStripe(context.Background(), i, 1)
+}
+
+// readStripe decodes every row of stripe i, decoding up to concurrency top level columns at once
+func (f *File) readStripe(ctx context.Context, i int, concurrency int) (*Batch, error) {
+	stripes := f.Footer.GetStripes()
+	if i < 0 || i >= len(stripes) {
+		return nil, fmt.Errorf("stripe %d out of range of %d stripes", i, len(stripes))
+	}
+	info := stripes[i]
+	s, err := f.loadStripe(i)
+	if err != nil {
+		return nil, err
+	}
+
+	n := int(info.GetNumberOfRows())
+	batch := &Batch{Stripe: i, NumRows: n}
+	types := f.Footer.GetTypes()
+	if len(types) == 0 {
+		return nil, fmt.Errorf("file has no types")
+	}
+
+	if types[0].GetKind() != Type_STRUCT {
+		column, err := f.newColumnReader(s, 0)
+		if err != nil {
+			return nil, corrupt("stripe", int64(info.GetOffset()), err)
+		}
+		values, err := decodeColumn(ctx, column, n)
+		if err != nil {
+			return nil, corrupt("stripe", int64(info.GetOffset()), err)
+		}
+		batch.Columns = [][]interface{}{values}
+		return batch, nil
+	}
+
+	// a null root struct has no values in its fields
+	present, count, err := s.presence(0).read(n)
+	if err != nil {
+		return nil, corrupt("stripe", int64(info.GetOffset()), err)
+	}
+
+	fields := types[0].GetSubtypes()
+	columns := make([]columnReader, len(fields))
+	for c, id := range fields {
+		if columns[c], err = f.newColumnReader(s, id); err != nil {
+			return nil, corrupt("stripe", int64(info.GetOffset()), err)
+		}
+	}
+
+	batch.Columns = make([][]interface{}, len(fields))
+	errs := make([]error, len(fields))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for c, column := range columns {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(c int, column columnReader) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			values, err := decodeColumn(ctx, column, count)
+			batch.Columns[c] = scatter(present, n, values)
+			errs[c] = err
+		}(c, column)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for c, err := range errs {
+		if err != nil {
+			return nil, corrupt("stripe", int64(info.GetOffset()),
+				fmt.Errorf("while decoding column %d of stripe %d: %w", fields[c], i, err))
+		}
+	}
+	return batch, nil
+}
+
+// decodeColumn decodes n values of a column, checking for cancellation as it goes
+func decodeColumn(ctx context.Context, column columnReader, n int) ([]interface{}, error) {
+	values := make([]interface{}, 0, n)
+	for len(values) < n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		chunk := n - len(values)
+		if chunk > rowsPerCheck {
+			chunk = rowsPerCheck
+		}
+		v, err := column.next(chunk)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v...)
+	}
+	return values, nil
+}
@@ -0,0 +1,89 @@
+package orc
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal is an arbitrary precision decimal number with the value Value * 10^-Scale
+type Decimal struct {
+	Value *big.Int
+	Scale int
+}
+
+func (d Decimal) String() string {
+	if d.Value == nil {
+		return "0"
+	}
+	digits := new(big.Int).Abs(d.Value).String()
+	sign := ""
+	if d.Value.Sign() < 0 {
+		sign = "-"
+	}
+	if d.Scale <= 0 {
+		return sign + digits + strings.Repeat("0", -d.Scale)
+	}
+	if len(digits) <= d.Scale {
+		digits = strings.Repeat("0", d.Scale-len(digits)+1) + digits
+	}
+	point := len(digits) - d.Scale
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// Rescale returns d with the given scale, rounding half away from zero when digits are dropped
+func (d Decimal) Rescale(scale int) Decimal {
+	value := d.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	switch {
+	case scale > d.Scale:
+		value = new(big.Int).Mul(value, pow10(scale-d.Scale))
+	case scale < d.Scale:
+		divisor := pow10(d.Scale - scale)
+		quotient, remainder := new(big.Int).QuoRem(value, divisor, new(big.Int))
+		if remainder.Abs(remainder).Lsh(remainder, 1).Cmp(divisor) >= 0 {
+			if value.Sign() < 0 {
+				quotient.Sub(quotient, big.NewInt(1))
+			} else {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		}
+		value = quotient
+	}
+	return Decimal{Value: value, Scale: scale}
+}
+
+// ParseDecimal parses a decimal in the plain notation of Decimal.String, its scale being the number of digits after
+// the point
+func ParseDecimal(s string) (Decimal, error) {
+	digits := s
+	scale := 0
+	if point := strings.IndexByte(s, '.'); point >= 0 {
+		digits = s[:point] + s[point+1:]
+		scale = len(s) - point - 1
+	}
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal{Value: value, Scale: scale}, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// MapEntry is a single key and value of a map column. Maps are decoded as a []MapEntry, preserving the order of the
+// entries in the file and allowing keys that are not comparable.
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// Union is a decoded value of a union column, Tag being the index of the variant in the union's subtypes
+type Union struct {
+	Tag   int
+	Value interface{}
+}
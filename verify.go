@@ -0,0 +1,404 @@
+package orc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// StatisticsMismatch is a statistic stored in a file which differs from the one computed from the values it
+// describes
+type StatisticsMismatch struct {
+	// Stripe is the index of the stripe of the statistics, -1 for those of the whole file
+	Stripe int
+	Column uint32
+	// Field names the statistic, such as "numberOfValues" or "intStatistics.minimum"
+	Field string
+	// Stored and Actual are the statistic as stored and as computed, in the types Stats returns. Actual is nil when
+	// the values have no such statistic.
+	Stored, Actual interface{}
+}
+
+func (m StatisticsMismatch) String() string {
+	where := "file"
+	if m.Stripe >= 0 {
+		where = fmt.Sprintf("stripe %d", m.Stripe)
+	}
+	return fmt.Sprintf("%s column %d %s: stored %v, actual %v", where, m.Column, m.Field, m.Stored, m.Actual)
+}
+
+// ComputeStatistics decodes every value of the file to compute the statistics of each column over the whole file
+// and of each stripe, whatever statistics the file stores
+func (f *File) ComputeStatistics(ctx context.Context) ([]Stats, [][]Stats, error) {
+	file, stripes, err := f.scanStatistics(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	stripeStats := make([][]Stats, len(stripes))
+	for i, s := range stripes {
+		stripeStats[i] = s.decoded()
+	}
+	return file.decoded(), stripeStats, nil
+}
+
+// VerifyStatistics decodes every value of the file to compare the statistics it stores for the whole file and for
+// each stripe with those of the values, so that files of writers computing them wrongly are caught before their
+// statistics are trusted to skip data. The statistics are compared as stored, including those Statistics leaves out
+// as computed wrongly by the writer. Statistics the file does not store are not compared, nor are those of masked
+// columns, nor timestamp statistics of stripes not recording the timezone of their writer.
+func (f *File) VerifyStatistics(ctx context.Context) ([]StatisticsMismatch, error) {
+	file, stripes, err := f.scanStatistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mismatches := f.compareStatistics(-1, f.Footer.GetStatistics(), file)
+	stored, err := f.storedStripeStatistics()
+	if errors.Is(err, ErrNoStripeStatistics) {
+		return mismatches, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, s := range stripes {
+		mismatches = append(mismatches, f.compareStatistics(i, stored[i].GetColStats(), s)...)
+	}
+	return mismatches, nil
+}
+
+// scanStatistics decodes every stripe to accumulate the statistics of its values
+func (f *File) scanStatistics(ctx context.Context) (*scannedStats, []*scannedStats, error) {
+	types := f.Footer.GetTypes()
+	if len(types) == 0 {
+		return nil, nil, fmt.Errorf("file has no types")
+	}
+	file := newScannedStats(types, time.UTC)
+	stripes := make([]*scannedStats, len(f.Footer.GetStripes()))
+	for i, info := range f.Footer.GetStripes() {
+		footer, err := f.GetStripeFooter(info)
+		if err != nil {
+			return nil, nil, err
+		}
+		loc, err := writerLocation(footer)
+		if err != nil {
+			return nil, nil, err
+		}
+		batch, err := f.readStripe(ctx, i, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		s := newScannedStats(types, loc)
+		s.unzoned = footer.GetWriterTimezone() == ""
+		for r := 0; r < batch.NumRows; r++ {
+			v := batch.Columns[0][r]
+			if types[0].GetKind() == Type_STRUCT {
+				v = batch.Row(r)
+			}
+			if err := s.add(0, v); err != nil {
+				return nil, nil, corrupt("stripe", int64(info.GetOffset()), err)
+			}
+		}
+		file.merge(s)
+		stripes[i] = s
+	}
+	return file, stripes, nil
+}
+
+// scannedStats accumulates the statistics of the values of each column of a stripe or file
+type scannedStats struct {
+	types   []*Type
+	columns []*columnStats
+	// instants accumulates the timestamps of timestamp columns as milliseconds of UTC, which writers before ORC-135
+	// store rather than milliseconds of the wall clock time of the writer
+	instants []*timestampStats
+	// unzoned is set when a stripe does not record the timezone of its writer, which older writers left as their
+	// local one, so that its timestamps are read in UTC and cannot be compared
+	unzoned bool
+}
+
+func newScannedStats(types []*Type, loc *time.Location) *scannedStats {
+	s := &scannedStats{
+		types:    types,
+		columns:  make([]*columnStats, len(types)),
+		instants: make([]*timestampStats, len(types)),
+	}
+	for id, t := range types {
+		s.columns[id] = newColumnStats(t, loc)
+		if t.GetKind() == Type_TIMESTAMP {
+			s.instants[id] = &timestampStats{loc: time.UTC}
+		}
+	}
+	return s
+}
+
+// add adds a value of column id, and those it holds of the columns under it
+func (s *scannedStats) add(id uint32, v interface{}) error {
+	stats := s.columns[id]
+	if v == nil {
+		stats.null()
+		return nil
+	}
+	t := s.types[id]
+	subtypes := t.GetSubtypes()
+	switch t.GetKind() {
+	case Type_BYTE, Type_SHORT, Type_INT, Type_LONG:
+		i, err := toInt64(v, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return fmt.Errorf("column %d: %w", id, err)
+		}
+		v = i
+	case Type_FLOAT:
+		if f, ok := v.(float32); ok {
+			v = float64(f)
+		}
+	case Type_DATE:
+		day, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("column %d: got %T for a date", id, v)
+		}
+		v = daysSinceEpoch(day)
+	case Type_TIMESTAMP:
+		ts, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("column %d: got %T for a timestamp", id, v)
+		}
+		s.instants[id].update(ts)
+	case Type_STRUCT:
+		fields, ok := v.([]interface{})
+		if !ok || len(fields) != len(subtypes) {
+			return fmt.Errorf("column %d: got %T for a struct of %d fields", id, v, len(subtypes))
+		}
+		for i, field := range fields {
+			if err := s.add(subtypes[i], field); err != nil {
+				return err
+			}
+		}
+	case Type_LIST:
+		elems, ok := v.([]interface{})
+		if !ok || len(subtypes) != 1 {
+			return fmt.Errorf("column %d: got %T for a list", id, v)
+		}
+		for _, elem := range elems {
+			if err := s.add(subtypes[0], elem); err != nil {
+				return err
+			}
+		}
+	case Type_MAP:
+		entries, ok := v.([]MapEntry)
+		if !ok || len(subtypes) != 2 {
+			return fmt.Errorf("column %d: got %T for a map", id, v)
+		}
+		for _, entry := range entries {
+			if err := s.add(subtypes[0], entry.Key); err != nil {
+				return err
+			}
+			if err := s.add(subtypes[1], entry.Value); err != nil {
+				return err
+			}
+		}
+	case Type_UNION:
+		u, ok := v.(Union)
+		if !ok || u.Tag < 0 || u.Tag >= len(subtypes) {
+			return fmt.Errorf("column %d: got %v for a union of %d variants", id, v, len(subtypes))
+		}
+		if err := s.add(subtypes[u.Tag], u.Value); err != nil {
+			return err
+		}
+	}
+	stats.update(v)
+	return nil
+}
+
+func (s *scannedStats) merge(other *scannedStats) {
+	s.unzoned = s.unzoned || other.unzoned
+	for id := range s.columns {
+		s.columns[id].merge(other.columns[id])
+		if s.instants[id] != nil {
+			s.instants[id].merge(other.instants[id])
+		}
+	}
+}
+
+func (s *scannedStats) decoded() []Stats {
+	stats := make([]Stats, len(s.columns))
+	for id, c := range s.columns {
+		stats[id] = &decodedStats{kind: s.types[id].GetKind(), stats: c}
+	}
+	return stats
+}
+
+// compareStatistics compares the statistics stored for stripe i, or the file when i is -1, with those computed
+func (f *File) compareStatistics(i int, stored []*ColumnStatistics, actual *scannedStats) []StatisticsMismatch {
+	var mismatches []StatisticsMismatch
+	for id, s := range stored {
+		column := uint32(id)
+		if id >= len(actual.columns) || f.Masked(column) || f.mask(column) != nil {
+			continue
+		}
+		if actual.unzoned && s.TimestampStatistics != nil {
+			s = proto.Clone(s).(*ColumnStatistics)
+			s.TimestampStatistics = nil
+		}
+		report := func(field string, stored, actual interface{}) {
+			mismatches = append(mismatches, StatisticsMismatch{Stripe: i, Column: column, Field: field, Stored: stored,
+				Actual: actual})
+		}
+		compareColumnStatistics(f.rebaseStatistics(s), actual.columns[id], actual.instants[id], report)
+	}
+	return mismatches
+}
+
+// compareColumnStatistics reports each statistic stored in s which differs from those of the values accumulated in
+// actual and, for timestamp columns, instants
+func compareColumnStatistics(s *ColumnStatistics, actual *columnStats, instants *timestampStats,
+	report func(field string, stored, actual interface{})) {
+	if s.NumberOfValues != nil && s.GetNumberOfValues() != actual.values {
+		report("numberOfValues", s.GetNumberOfValues(), actual.values)
+	}
+	if s.HasNull != nil && s.GetHasNull() != actual.hasNull {
+		report("hasNull", s.GetHasNull(), actual.hasNull)
+	}
+	// known returns the accumulated value when there is one, nil otherwise
+	known := func(set bool, v interface{}) interface{} {
+		if set {
+			return v
+		}
+		return nil
+	}
+
+	switch a := actual.typed.(type) {
+	case *bucketStats:
+		if count := s.GetBucketStatistics().GetCount(); len(count) > 0 && count[0] != a.trues {
+			report("bucketStatistics.count", count[0], a.trues)
+		}
+	case *intStats:
+		is := s.GetIntStatistics()
+		if is == nil {
+			break
+		}
+		if is.Minimum != nil && (!a.set || is.GetMinimum() != a.min) {
+			report("intStatistics.minimum", is.GetMinimum(), known(a.set, a.min))
+		}
+		if is.Maximum != nil && (!a.set || is.GetMaximum() != a.max) {
+			report("intStatistics.maximum", is.GetMaximum(), known(a.set, a.max))
+		}
+		if is.Sum != nil && (a.overflow || is.GetSum() != a.sum) {
+			report("intStatistics.sum", is.GetSum(), known(!a.overflow, a.sum))
+		}
+	case *doubleStats:
+		ds := s.GetDoubleStatistics()
+		if ds == nil {
+			break
+		}
+		if ds.Minimum != nil && (!a.set || ds.GetMinimum() != a.min) {
+			report("doubleStatistics.minimum", ds.GetMinimum(), known(a.set, a.min))
+		}
+		if ds.Maximum != nil && (!a.set || ds.GetMaximum() != a.max) {
+			report("doubleStatistics.maximum", ds.GetMaximum(), known(a.set, a.max))
+		}
+		if ds.Sum != nil && !sameSum(ds.GetSum(), a.sum) {
+			report("doubleStatistics.sum", ds.GetSum(), a.sum)
+		}
+	case *stringStats:
+		ss := s.GetStringStatistics()
+		if ss == nil {
+			break
+		}
+		if ss.Minimum != nil && (!a.set || ss.GetMinimum() != a.min) {
+			report("stringStatistics.minimum", ss.GetMinimum(), known(a.set, a.min))
+		}
+		if ss.Maximum != nil && (!a.set || ss.GetMaximum() != a.max) {
+			report("stringStatistics.maximum", ss.GetMaximum(), known(a.set, a.max))
+		}
+		// bounds of truncated values need only hold the values
+		if ss.LowerBound != nil && (!a.set || ss.GetLowerBound() > a.min) {
+			report("stringStatistics.lowerBound", ss.GetLowerBound(), known(a.set, a.min))
+		}
+		if ss.UpperBound != nil && (!a.set || ss.GetUpperBound() < a.max) {
+			report("stringStatistics.upperBound", ss.GetUpperBound(), known(a.set, a.max))
+		}
+		if ss.Sum != nil && ss.GetSum() != a.sum {
+			report("stringStatistics.sum", ss.GetSum(), a.sum)
+		}
+	case *binaryStats:
+		if bs := s.GetBinaryStatistics(); bs != nil && bs.Sum != nil && bs.GetSum() != a.sum {
+			report("binaryStatistics.sum", bs.GetSum(), a.sum)
+		}
+	case *dateStats:
+		ds := s.GetDateStatistics()
+		if ds == nil {
+			break
+		}
+		if ds.Minimum != nil && (!a.set || int64(ds.GetMinimum()) != a.min) {
+			report("dateStatistics.minimum", dateOfDays(int64(ds.GetMinimum())), known(a.set, dateOfDays(a.min)))
+		}
+		if ds.Maximum != nil && (!a.set || int64(ds.GetMaximum()) != a.max) {
+			report("dateStatistics.maximum", dateOfDays(int64(ds.GetMaximum())), known(a.set, dateOfDays(a.max)))
+		}
+	case *timestampStats:
+		ts := s.GetTimestampStatistics()
+		if ts == nil {
+			break
+		}
+		for _, field := range []struct {
+			name   string
+			stored *int64
+			actual *timestampStats
+			max    bool
+		}{
+			{"timestampStatistics.minimum", ts.Minimum, instants, false},
+			{"timestampStatistics.maximum", ts.Maximum, instants, true},
+			{"timestampStatistics.minimumUtc", ts.MinimumUtc, a, false},
+			{"timestampStatistics.maximumUtc", ts.MaximumUtc, a, true},
+		} {
+			if field.stored == nil {
+				continue
+			}
+			millis := field.actual.min
+			if field.max {
+				millis = field.actual.max
+			}
+			if !field.actual.set || *field.stored != millis {
+				report(field.name, timeOfMillis(*field.stored), known(field.actual.set, timeOfMillis(millis)))
+			}
+		}
+	case *decimalStats:
+		ds := s.GetDecimalStatistics()
+		if ds == nil {
+			break
+		}
+		for _, field := range []struct {
+			name   string
+			stored *string
+			actual Decimal
+			set    bool
+		}{
+			{"decimalStatistics.minimum", ds.Minimum, a.min, a.set},
+			{"decimalStatistics.maximum", ds.Maximum, a.max, a.set},
+			{"decimalStatistics.sum", ds.Sum, a.sum, !a.overflow},
+		} {
+			if field.stored == nil {
+				continue
+			}
+			d, err := ParseDecimal(*field.stored)
+			if err != nil || !field.set || compareDecimal(d, field.actual) != 0 {
+				report(field.name, *field.stored, known(field.set, copyDecimal(field.actual)))
+			}
+		}
+	}
+}
+
+// sameSum reports whether two sums of floating point values are equal but for rounding, which depends on the order
+// in which they were added
+func sameSum(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b) <= 1e-9*math.Max(math.Abs(a), math.Abs(b))
+}
@@ -0,0 +1,89 @@
+package orc
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVerifyStatistics(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Open(writeAllTypes(t, 5000, &WriterOptions{StripeSize: 16 * 1024, Timezone: la}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	if mismatches, err := f.VerifyStatistics(ctx); err != nil || len(mismatches) != 0 {
+		t.Fatalf("got mismatches %v, %v", mismatches, err)
+	}
+
+	file, stripes, err := f.ComputeStatistics(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := f.StripeColumnStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stripes) != len(stored) || len(stripes) < 2 {
+		t.Fatalf("got statistics of %d stripes; want %d", len(stripes), len(stored))
+	}
+	for column, want := range f.ColumnStats() {
+		if got := file[column].Statistics(); !proto.Equal(got, want.Statistics()) {
+			t.Errorf("column %d: got computed statistics %v; want %v", column, got, want.Statistics())
+		}
+		if got := stripes[1][column].Statistics(); !proto.Equal(got, stored[1][column].Statistics()) {
+			t.Errorf("stripe 1 column %d: got computed statistics %v; want %v", column, got,
+				stored[1][column].Statistics())
+		}
+	}
+
+	f.Footer.GetStatistics()[4].IntStatistics.Minimum = proto.Int64(7)
+	metadata, err := f.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata.GetStripeStats()[1].GetColStats()[8].GetStringStatistics().Maximum = proto.String("z")
+	mismatches, err := f.VerifyStatistics(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []StatisticsMismatch{
+		{Stripe: -1, Column: 4, Field: "intStatistics.minimum", Stored: int64(7), Actual: int64(0)},
+		{Stripe: 1, Column: 8, Field: "stringStatistics.maximum", Stored: "z", Actual: "c"},
+	}
+	if !reflect.DeepEqual(mismatches, want) {
+		t.Errorf("got mismatches %v; want %v", mismatches, want)
+	}
+}
+
+func TestVerifyStatisticsJava(t *testing.T) {
+	for name, want := range map[string]int{
+		// timestamp statistics from before ORC-135
+		"TestOrcFile.testUnionAndTimestamp.orc":         0,
+		"TestOrcFile.testStringAndBinaryStatistics.orc": 0,
+		"decimal.orc": 0,
+		// timestamps of a writer not recording its timezone, which was not UTC
+		"over1k_bloom.orc": 0,
+		// the file statistics of writers without row indexes count no values
+		"TestOrcFile.testWithoutIndex.orc": 44,
+	} {
+		f, err := Open(filepath.Join("examples", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mismatches, err := f.VerifyStatistics(context.Background())
+		f.Close()
+		if err != nil || len(mismatches) != want {
+			t.Errorf("%s: got %d mismatches, %v; want %d", name, len(mismatches), err, want)
+		}
+	}
+}
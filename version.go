@@ -0,0 +1,119 @@
+package orc
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// WriterImplementation identifies the library that wrote a file, from Footer.Writer
+type WriterImplementation uint32
+
+const (
+	ORC_JAVA WriterImplementation = iota
+	ORC_CPP
+	PRESTO
+	SCRITCHLEY_GO
+	TRINO
+	CUDF
+)
+
+var writerImplementations = map[WriterImplementation]string{
+	ORC_JAVA:      "ORC Java",
+	ORC_CPP:       "ORC C++",
+	PRESTO:        "Presto",
+	SCRITCHLEY_GO: "Scritchley Go",
+	TRINO:         "Trino",
+	CUDF:          "CUDF",
+}
+
+func (w WriterImplementation) String() string {
+	if s, ok := writerImplementations[w]; ok {
+		return s
+	}
+	return fmt.Sprintf("unknown writer %d", uint32(w))
+}
+
+// WriterVersion is PostScript.WriterVersion, naming the last fix included by the writer. Versions up to ORC_101 are
+// only written by ORC_JAVA, the others sharing the later versions.
+type WriterVersion uint32
+
+const (
+	// WRITER_ORIGINAL predates all fixes
+	WRITER_ORIGINAL WriterVersion = iota
+	// HIVE_8732 fixes stripe and file maxima, comparing strings as UTF-8 in statistics
+	HIVE_8732
+	// HIVE_4243 writes the real column names rather than _col0, _col1, ...
+	HIVE_4243
+	// HIVE_12055 is the vectorized writer, the first to hash strings correctly in bloom filters
+	HIVE_12055
+	// HIVE_13083 writes the PRESENT stream of decimal columns correctly
+	HIVE_13083
+	// ORC_101 hashes strings as UTF-8 in bloom filters, written to BLOOM_FILTER_UTF8 streams
+	ORC_101
+	// ORC_135 writes timestamp statistics in UTC rather than local time, the first version of other writers
+	ORC_135
+	// ORC_517 writes the statistics of decimal64 columns correctly
+	ORC_517
+	// ORC_203 truncates long string statistics to bounds
+	ORC_203
+	// ORC_14 adds column encryption
+	ORC_14
+)
+
+var writerVersions = map[WriterVersion]string{
+	WRITER_ORIGINAL: "original",
+	HIVE_8732:       "HIVE-8732",
+	HIVE_4243:       "HIVE-4243",
+	HIVE_12055:      "HIVE-12055",
+	HIVE_13083:      "HIVE-13083",
+	ORC_101:         "ORC-101",
+	ORC_135:         "ORC-135",
+	ORC_517:         "ORC-517",
+	ORC_203:         "ORC-203",
+	ORC_14:          "ORC-14",
+}
+
+func (v WriterVersion) String() string {
+	if s, ok := writerVersions[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("future (%d)", uint32(v))
+}
+
+// Writer returns the library that wrote the file, ORC_JAVA for files that do not say
+func (f *File) Writer() WriterImplementation {
+	return WriterImplementation(f.Footer.GetWriter())
+}
+
+// WriterVersion returns the version of the writer of the file
+func (f *File) WriterVersion() WriterVersion {
+	return WriterVersion(f.PostScript.GetWriterVersion())
+}
+
+// WriterIncludes reports whether the writer of the file includes a fix, as other writers than ORC_JAVA always do
+func (f *File) WriterIncludes(fix WriterVersion) bool {
+	return f.Writer() != ORC_JAVA || f.WriterVersion() >= fix
+}
+
+// trustedStatistics returns the statistics of a column without the values its writer is known to have computed wrongly
+func (f *File) trustedStatistics(column uint32, s *ColumnStatistics) *ColumnStatistics {
+	types := f.Footer.GetTypes()
+	if s == nil || int(column) >= len(types) {
+		return s
+	}
+	switch types[column].GetKind() {
+	case Type_STRING, Type_CHAR, Type_VARCHAR:
+		if s.StringStatistics != nil && !f.WriterIncludes(HIVE_8732) {
+			s = proto.Clone(s).(*ColumnStatistics)
+			s.StringStatistics.Minimum, s.StringStatistics.Maximum = nil, nil
+		}
+	case Type_TIMESTAMP:
+		// earlier writers used the local time of wherever they ran
+		if s.TimestampStatistics != nil && !f.WriterIncludes(ORC_135) {
+			s = proto.Clone(s).(*ColumnStatistics)
+			s.TimestampStatistics = nil
+		}
+	}
+	return s
+}
@@ -0,0 +1,203 @@
+package orc
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWriterVersion(t *testing.T) {
+	for _, test := range []struct {
+		filename string
+		want     WriterVersion
+		name     string
+	}{
+		{"examples/orc_split_elim.orc", WRITER_ORIGINAL, "original"},
+		{"examples/over1k_bloom.orc", HIVE_8732, "HIVE-8732"},
+		{"examples/TestVectorOrcFile.testLzo.orc", HIVE_13083, "HIVE-13083"},
+	} {
+		f, err := Open(test.filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := f.WriterVersion(); v != test.want || v.String() != test.name {
+			t.Errorf("%s: got writer version %d (%s); want %d (%s)", test.filename, v, v, test.want, test.name)
+		}
+		if w := f.Writer(); w != ORC_JAVA || w.String() != "ORC Java" {
+			t.Errorf("%s: got writer %s", test.filename, w)
+		}
+		if !f.WriterIncludes(test.want) || f.WriterIncludes(test.want+1) {
+			t.Errorf("%s: writer includes the wrong fixes", test.filename)
+		}
+		f.Close()
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []*Type{{Kind: Type_LONG.Enum()}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if v := openBytes(t, buf.Bytes()).WriterVersion(); v != ORC_14 {
+		t.Errorf("got writer version %s; want ORC-14", v)
+	}
+
+	if s := WriterVersion(42).String(); s != "future (42)" {
+		t.Errorf("got %q for an unknown writer version", s)
+	}
+}
+
+func TestWriterImplementation(t *testing.T) {
+	f := &File{
+		Footer:     Footer{Writer: proto.Uint32(uint32(ORC_CPP))},
+		PostScript: PostScript{WriterVersion: proto.Uint32(6)},
+	}
+	if w := f.Writer(); w != ORC_CPP || w.String() != "ORC C++" {
+		t.Errorf("got writer %d (%s); want ORC C++", w, w)
+	}
+	// fixes of the Java writer do not concern others
+	if !f.WriterIncludes(ORC_14) {
+		t.Error("C++ writer does not include ORC-14")
+	}
+	if s := WriterImplementation(42).String(); s != "unknown writer 42" {
+		t.Errorf("got %q for an unknown writer", s)
+	}
+}
+
+func TestWrittenStatisticsTrusted(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2}, FieldNames: []string{"s", "t"}},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_TIMESTAMP.Enum()},
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, types, &WriterOptions{RowIndexStride: 10, Timezone: time.UTC})
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 20; i++ {
+		if err := w.WriteRow([]interface{}{fmt.Sprintf("value %02d", i), day.Add(time.Duration(i) * time.Hour)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f := openBytes(t, buf.Bytes())
+
+	// the statistics of files this package writes are those of the latest writers, which readers trust
+	strings, err := f.RowGroups(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timestamps, err := f.RowGroups(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(strings) != 2 || len(timestamps) != 2 {
+		t.Fatalf("got %d and %d row groups; want 2", len(strings), len(timestamps))
+	}
+	for g := range strings {
+		if s := strings[g].Statistics.GetStringStatistics(); s.GetMinimum() != fmt.Sprintf("value %02d", g*10) {
+			t.Errorf("row group %d: got string statistics %v", g, s)
+		}
+		want := day.Add(time.Duration(g*10) * time.Hour).UnixMilli()
+		if ts := timestamps[g].Statistics.GetTimestampStatistics(); ts.GetMinimumUtc() != want {
+			t.Errorf("row group %d: got timestamp statistics %v; want a minimum of %d", g, ts, want)
+		}
+	}
+}
+
+func TestTrustedStatistics(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2}, FieldNames: []string{"s", "t"}},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_TIMESTAMP.Enum()},
+	}
+	stats := &ColumnStatistics{
+		NumberOfValues:   proto.Uint64(2),
+		StringStatistics: &StringStatistics{Minimum: proto.String("a"), Maximum: proto.String("b"), Sum: proto.Int64(2)},
+	}
+	timestamps := &ColumnStatistics{
+		NumberOfValues:      proto.Uint64(2),
+		TimestampStatistics: &TimestampStatistics{Minimum: proto.Int64(1), Maximum: proto.Int64(2)},
+	}
+	for _, test := range []struct {
+		version            WriterVersion
+		strings, timestamp bool
+	}{
+		{WRITER_ORIGINAL, false, false},
+		{HIVE_8732, true, false},
+		{ORC_101, true, false},
+		{ORC_135, true, true},
+	} {
+		f := &File{PostScript: PostScript{WriterVersion: proto.Uint32(uint32(test.version))}, Footer: Footer{Types: types}}
+		got := f.trustedStatistics(1, stats)
+		if (got.GetStringStatistics().Minimum != nil) != test.strings || got.GetStringStatistics().GetSum() != 2 {
+			t.Errorf("%s: got string statistics %v", test.version, got)
+		}
+		got = f.trustedStatistics(2, timestamps)
+		if (got.GetTimestampStatistics() != nil) != test.timestamp || got.GetNumberOfValues() != 2 {
+			t.Errorf("%s: got timestamp statistics %v", test.version, got)
+		}
+	}
+	// the statistics themselves are left alone
+	if stats.StringStatistics.Minimum == nil || timestamps.TimestampStatistics == nil {
+		t.Error("modified the statistics")
+	}
+}
+
+func TestTrustedStatisticsJava(t *testing.T) {
+	for _, test := range []struct {
+		filename           string
+		strings, timestamp bool
+	}{
+		// written before HIVE-8732 fixed string bounds and ORC-135 made timestamp statistics UTC
+		{"examples/orc_split_elim.orc", false, false},
+		{"examples/over1k_bloom.orc", true, false},
+	} {
+		f, err := Open(test.filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stripes, err := f.StripeStatistics()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// read after Statistics, which must leave the footer as stored
+		read, stored := f.Statistics(), f.Footer.GetStatistics()
+		var checked int
+		for id, s := range read {
+			if stored[id].GetStringStatistics().GetMinimum() != "" {
+				checked++
+				if got := s.GetStringStatistics().Minimum != nil; got != test.strings {
+					t.Errorf("%s column %d: got string bounds %t; want %t", test.filename, id, got, test.strings)
+				}
+				if got := stripes[0].GetColStats()[id].GetStringStatistics().Minimum != nil; got != test.strings {
+					t.Errorf("%s column %d: got stripe string bounds %t; want %t", test.filename, id, got,
+						test.strings)
+				}
+				if got := f.ColumnStats()[id].Min() != nil; got != test.strings {
+					t.Errorf("%s column %d: got a decoded minimum %t; want %t", test.filename, id, got, test.strings)
+				}
+			}
+			if stored[id].TimestampStatistics != nil {
+				checked++
+				if got := s.TimestampStatistics != nil; got != test.timestamp {
+					t.Errorf("%s column %d: got timestamp statistics %t; want %t", test.filename, id, got,
+						test.timestamp)
+				}
+			}
+		}
+		if checked == 0 {
+			t.Errorf("%s: no string or timestamp statistics", test.filename)
+		}
+		f.Close()
+	}
+}
@@ -0,0 +1,679 @@
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DEFAULT_STRIPE_SIZE            = 64 * 1024 * 1024
+	DEFAULT_COMPRESSION_BLOCK_SIZE = 256 * 1024
+	DEFAULT_ROW_INDEX_STRIDE       = 10000
+	// DEFAULT_DICTIONARY_KEY_SIZE_THRESHOLD is the largest ratio of distinct values to values for which string
+	// columns are dictionary encoded, as in Hive
+	DEFAULT_DICTIONARY_KEY_SIZE_THRESHOLD = 0.8
+	DEFAULT_DICTIONARY_CHECK_ROWS         = 10000
+	// maxChunkLength is the largest compression chunk representable by the 3 byte chunk header
+	maxChunkLength = 1<<23 - 1
+)
+
+// writerVersion is the PostScript.WriterVersion reported by files this package writes, that of the last fix it
+// implements: it writes timestamp statistics in UTC, bounds of long strings and encrypted columns
+const writerVersion = uint32(ORC_14)
+
+// errWriterClosed is returned when writing to a closed Writer
+var errWriterClosed = errors.New("writer closed")
+
+// WriterOptions configures a Writer
+type WriterOptions struct {
+	// Compression is the codec applied to every stream, NONE, ZLIB and SNAPPY are supported
+	Compression CompressionKind
+	// CompressionBlockSize is the largest uncompressed size of a compression chunk, defaulting to 256 KB
+	CompressionBlockSize int
+	// StripeSize is the number of buffered bytes at which a stripe is written, defaulting to 64 MB
+	StripeSize int64
+	// Timezone is the writer timezone timestamps are encoded relative to, defaulting to UTC. It must be a
+	// location loaded by name.
+	Timezone *time.Location
+	// RowIndexStride is the number of rows in each row group of the row indexes, defaulting to
+	// DEFAULT_ROW_INDEX_STRIDE. A negative stride writes no row indexes.
+	RowIndexStride int
+	// BloomFilterColumns lists the ids of the columns given bloom filters, one for each row group
+	BloomFilterColumns []uint32
+	// BloomFilterFPP is the false positive probability of the bloom filters, defaulting to DEFAULT_BLOOM_FILTER_FPP
+	BloomFilterFPP float64
+	// DictionaryKeySizeThreshold is the largest ratio of distinct values to values for which the strings of a stripe
+	// are dictionary encoded, defaulting to DEFAULT_DICTIONARY_KEY_SIZE_THRESHOLD. A negative threshold always
+	// writes strings directly.
+	DictionaryKeySizeThreshold float64
+	// UserMetadata is attached to the file, to be read back by File.UserMetadata. Values may also be added by
+	// Writer.AddUserMetadata.
+	UserMetadata map[string][]byte
+	// MemoryManager, when set, is shared with other writers to keep the bytes they buffer within its budget
+	MemoryManager *MemoryManager
+	// DictionaryCheckRows is the number of values of each stripe after which a string column whose ratio exceeds
+	// the threshold switches to direct encoding and drops its dictionary, defaulting to
+	// DEFAULT_DICTIONARY_CHECK_ROWS. A negative count only checks the ratio once the stripe is written.
+	DictionaryCheckRows int
+	// EncryptedColumns maps the ids of the columns to encrypt to the name of their master key in KeyProvider. The
+	// subtree of each column is encrypted, a masked copy holding only nulls being written in its place for readers
+	// without the key. The subtrees may not overlap, nor be the whole schema.
+	EncryptedColumns map[uint32]string
+	// KeyProvider holds the master keys of EncryptedColumns
+	KeyProvider KeyProvider
+	// HybridCalendar writes dates and timestamps in the hybrid Julian and Gregorian calendar, with the year, month
+	// and day they have in the proleptic Gregorian calendar of the time package, for readers that assume the hybrid
+	// calendar such as Hive before 3.1. Files are otherwise written in the proleptic Gregorian calendar.
+	HybridCalendar bool
+}
+
+// Writer writes rows to an ORC file. A Writer is not safe for concurrent use.
+type Writer struct {
+	w      io.Writer
+	opts   WriterOptions
+	loc    *time.Location
+	types  []*Type
+	root   columnWriter
+	offset uint64
+	err    error
+	closed bool
+
+	// columns holds the writer of each column, by column id
+	columns []columnWriter
+	// bloomFilters holds whether each column has bloom filters, by column id
+	bloomFilters []bool
+	// variants holds the encryption variants of the encrypted columns in column order, and encryptionKeys the
+	// master keys they use
+	variants       []*writerVariant
+	encryptionKeys []*EncryptionKey
+
+	// stripeSize is the number of buffered bytes at which a stripe is written, as scaled by the memory manager
+	stripeSize int64
+	memory     *MemoryManager
+	// memoryRows counts the rows written since the last report to the memory manager
+	memoryRows int
+
+	// groupRows counts the rows of the current row group, zero before it is started
+	groupRows   int
+	stripeRows  uint64
+	numRows     uint64
+	stripes     []*StripeInformation
+	stripeStats []*StripeStatistics
+	fileStats   []*columnStats
+
+	// fileVersion and writerVersion are written to the PostScript and calendar to the Footer, those of the source
+	// files when merging
+	fileVersion   []uint32
+	writerVersion uint32
+	calendar      CalendarKind
+	metadata      []*UserMetadataItem
+}
+
+// NewWriter writes an ORC file with the given schema to w, types being in the flattened pre-order layout of
+// Footer.Types. opts may be nil for the defaults, ZLIB compression among them.
+func NewWriter(w io.Writer, types []*Type, opts *WriterOptions) (*Writer, error) {
+	if err := checkTypes(types); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &WriterOptions{Compression: CompressionKind_ZLIB}
+	}
+	o := *opts
+	switch o.Compression {
+	case CompressionKind_NONE, CompressionKind_ZLIB, CompressionKind_SNAPPY:
+	default:
+		return nil, &UnsupportedError{Feature: "compression " + o.Compression.String()}
+	}
+	if o.CompressionBlockSize <= 0 {
+		o.CompressionBlockSize = DEFAULT_COMPRESSION_BLOCK_SIZE
+	}
+	if o.CompressionBlockSize > maxChunkLength {
+		return nil, fmt.Errorf("compression block size %d exceeds %d", o.CompressionBlockSize, maxChunkLength)
+	}
+	if o.StripeSize <= 0 {
+		o.StripeSize = DEFAULT_STRIPE_SIZE
+	}
+	if o.RowIndexStride == 0 {
+		o.RowIndexStride = DEFAULT_ROW_INDEX_STRIDE
+	}
+	if o.DictionaryKeySizeThreshold == 0 {
+		o.DictionaryKeySizeThreshold = DEFAULT_DICTIONARY_KEY_SIZE_THRESHOLD
+	}
+	if o.DictionaryCheckRows == 0 {
+		o.DictionaryCheckRows = DEFAULT_DICTIONARY_CHECK_ROWS
+	}
+	if o.BloomFilterFPP == 0 {
+		o.BloomFilterFPP = DEFAULT_BLOOM_FILTER_FPP
+	}
+	if o.BloomFilterFPP <= 0 || o.BloomFilterFPP >= 1 {
+		return nil, fmt.Errorf("bloom filter false positive probability %g not between 0 and 1", o.BloomFilterFPP)
+	}
+	bloomFilters := make([]bool, len(types))
+	for _, id := range o.BloomFilterColumns {
+		if int(id) >= len(types) {
+			return nil, fmt.Errorf("bloom filter column %d not in schema", id)
+		}
+		if o.RowIndexStride < 0 {
+			return nil, fmt.Errorf("bloom filters require row indexes")
+		}
+		if err := checkBloomFilterKind(types[id].GetKind()); err != nil {
+			return nil, fmt.Errorf("column %d: %w", id, err)
+		}
+		bloomFilters[id] = true
+	}
+
+	ow := &Writer{
+		w:             w,
+		opts:          o,
+		loc:           time.UTC,
+		stripeSize:    o.StripeSize,
+		memory:        o.MemoryManager,
+		types:         types,
+		columns:       make([]columnWriter, len(types)),
+		bloomFilters:  bloomFilters,
+		fileStats:     make([]*columnStats, len(types)),
+		fileVersion:   []uint32{0, 12},
+		writerVersion: writerVersion,
+		calendar:      CalendarKind_PROLEPTIC_GREGORIAN,
+	}
+	if o.HybridCalendar {
+		ow.calendar = CalendarKind_JULIAN_GREGORIAN
+	}
+	if o.Timezone != nil {
+		ow.loc = o.Timezone
+	}
+	for id, t := range types {
+		ow.fileStats[id] = newColumnStats(t, ow.loc)
+	}
+	variants, keys, err := newWriterVariants(types, &o, ow.loc)
+	if err != nil {
+		return nil, err
+	}
+	ow.variants, ow.encryptionKeys = variants, keys
+	root, err := ow.newColumnWriter(0)
+	if err != nil {
+		return nil, err
+	}
+	ow.root = root
+
+	if err := ow.write([]byte(MAGIC)); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(o.UserMetadata))
+	for name := range o.UserMetadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ow.AddUserMetadata(name, o.UserMetadata[name])
+	}
+	if ow.memory != nil {
+		ow.memory.add(ow)
+	}
+	return ow, nil
+}
+
+// checkTypes validates that types describe a single tree numbered in pre-order with consistent subtypes
+func checkTypes(types []*Type) error {
+	if len(types) == 0 {
+		return fmt.Errorf("schema has no types")
+	}
+	next := uint32(1)
+	var visit func(id uint32) error
+	visit = func(id uint32) error {
+		t := types[id]
+		subtypes := t.GetSubtypes()
+		switch t.GetKind() {
+		case Type_STRUCT:
+			if len(t.GetFieldNames()) != len(subtypes) {
+				return fmt.Errorf("struct type %d has %d field names for %d subtypes", id, len(t.GetFieldNames()), len(subtypes))
+			}
+		case Type_LIST:
+			if len(subtypes) != 1 {
+				return fmt.Errorf("list type %d has %d subtypes", id, len(subtypes))
+			}
+		case Type_MAP:
+			if len(subtypes) != 2 {
+				return fmt.Errorf("map type %d has %d subtypes", id, len(subtypes))
+			}
+		case Type_UNION:
+			if len(subtypes) == 0 || len(subtypes) > 256 {
+				return fmt.Errorf("union type %d has %d subtypes", id, len(subtypes))
+			}
+		default:
+			if len(subtypes) != 0 {
+				return fmt.Errorf("%s type %d has subtypes", t.GetKind(), id)
+			}
+		}
+		for _, sub := range subtypes {
+			if sub != next || int(sub) >= len(types) {
+				return fmt.Errorf("type %d has subtype %d, expected %d", id, sub, next)
+			}
+			next++
+			if err := visit(sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(0); err != nil {
+		return err
+	}
+	if int(next) != len(types) {
+		return fmt.Errorf("types %d to %d are not part of the schema", next, len(types)-1)
+	}
+	return nil
+}
+
+// WriteRow writes a single row, holding one value for each field of the root struct, or a single value when the
+// root type is not a struct. Values use the representations documented on File.Rows.
+func (w *Writer) WriteRow(row []interface{}) error {
+	if w.closed {
+		return errWriterClosed
+	}
+	if w.err != nil {
+		return w.err
+	}
+	var v interface{} = row
+	if w.types[0].GetKind() != Type_STRUCT {
+		if len(row) != 1 {
+			return fmt.Errorf("got %d values for a schema of a single %s", len(row), w.types[0].GetKind())
+		}
+		v = row[0]
+	}
+	if w.opts.RowIndexStride > 0 && w.groupRows == 0 {
+		w.startGroup()
+	}
+	if err := w.root.write(v); err != nil {
+		// a partially written row leaves the columns out of step
+		w.err = err
+		return err
+	}
+	w.stripeRows++
+	if w.groupRows++; w.groupRows == w.opts.RowIndexStride {
+		w.finishGroup()
+	}
+	if w.checkMemory() || w.root.size() >= w.stripeSize {
+		return w.flushStripe()
+	}
+	return nil
+}
+
+// startGroup begins a row group in every column, those of the encryption variants included
+func (w *Writer) startGroup() {
+	for _, c := range w.columns {
+		c.startGroup()
+	}
+	for _, v := range w.variants {
+		for _, c := range v.columns {
+			c.startGroup()
+		}
+	}
+}
+
+// finishGroup completes the current row group, which without row indexes spans the stripe
+func (w *Writer) finishGroup() {
+	for _, c := range w.columns {
+		c.finishGroup()
+	}
+	for _, v := range w.variants {
+		for _, c := range v.columns {
+			c.finishGroup()
+		}
+	}
+	w.groupRows = 0
+}
+
+// AddUserMetadata attaches a value to the file under the given name, replacing any value added before. The
+// metadata is written by Close.
+func (w *Writer) AddUserMetadata(name string, value []byte) error {
+	if w.closed {
+		return errWriterClosed
+	}
+	for _, item := range w.metadata {
+		if item.GetName() == name {
+			item.Value = value
+			return nil
+		}
+	}
+	w.metadata = append(w.metadata, &UserMetadataItem{Name: &name, Value: value})
+	return nil
+}
+
+// WriteBatch writes every row of a batch
+func (w *Writer) WriteBatch(b *Batch) error {
+	for i := 0; i < b.NumRows; i++ {
+		if err := w.WriteRow(b.Row(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close writes any buffered rows and the file tail. It does not close the underlying io.Writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return errWriterClosed
+	}
+	w.closed = true
+	if w.memory != nil {
+		defer w.memory.remove(w)
+	}
+	if w.err != nil {
+		return w.err
+	}
+	if w.stripeRows > 0 {
+		if err := w.flushStripe(); err != nil {
+			return err
+		}
+	}
+	return w.writeTail()
+}
+
+func (w *Writer) write(p []byte) error {
+	n, err := w.w.Write(p)
+	w.offset += uint64(n)
+	if err != nil {
+		w.err = err
+	}
+	return err
+}
+
+// stripeWriter collects the streams and encodings of a stripe as the columns are flushed
+type stripeWriter struct {
+	streams   []*Stream
+	data      [][]byte
+	indexes   []*Stream
+	messages  []proto.Message
+	encodings []*ColumnEncoding
+	// variants collects the streams of each encryption variant, by variant id
+	variants []*stripeWriter
+}
+
+// addStream adds a data stream to the stripe, which the caller may then reset. Streams must be added in the order
+// they are to be written.
+func (s *stripeWriter) addStream(column uint32, kind Stream_Kind, stream *outStream) {
+	data := append([]byte(nil), stream.finish()...)
+	length := uint64(len(data))
+	s.streams = append(s.streams, &Stream{Kind: kind.Enum(), Column: &column, Length: &length})
+	s.data = append(s.data, data)
+}
+
+// addIndex adds an index stream to the stripe, written before every data stream
+func (s *stripeWriter) addIndex(column uint32, kind Stream_Kind, m proto.Message) {
+	s.indexes = append(s.indexes, &Stream{Kind: kind.Enum(), Column: &column})
+	s.messages = append(s.messages, m)
+}
+
+func (s *stripeWriter) setEncoding(column uint32, encoding *ColumnEncoding) {
+	s.encodings[column] = encoding
+}
+
+func (w *Writer) flushStripe() error {
+	if w.groupRows > 0 {
+		w.finishGroup()
+	}
+	stats := make([]*ColumnStatistics, len(w.columns))
+	for id, c := range w.columns {
+		stripe := c.stripeStatistics()
+		stats[id] = stripe.statistics()
+		w.fileStats[id].merge(stripe)
+	}
+	for _, v := range w.variants {
+		for j, c := range v.columns {
+			stripe := c.stripeStatistics()
+			v.stripeStats[j] = append(v.stripeStats[j], stripe.statistics())
+			v.fileStats[j].merge(stripe)
+		}
+	}
+
+	s := &stripeWriter{
+		encodings: make([]*ColumnEncoding, len(w.columns)),
+		variants:  make([]*stripeWriter, len(w.variants)),
+	}
+	for i := range w.variants {
+		s.variants[i] = &stripeWriter{encodings: make([]*ColumnEncoding, len(w.columns))}
+	}
+	w.root.flush(s)
+
+	info := &StripeInformation{Offset: proto.Uint64(w.offset), NumberOfRows: proto.Uint64(w.stripeRows)}
+	stripeID := uint64(len(w.stripes)) + 1
+	if len(w.variants) > 0 && len(w.stripes) == 0 {
+		// later stripes keep the local keys of the first
+		info.EncryptStripeId = proto.Uint64(stripeID)
+		for _, v := range w.variants {
+			info.EncryptedLocalKeys = append(info.EncryptedLocalKeys, v.encrypted)
+		}
+	}
+
+	// the streams of the encryption variants follow the unencrypted index and data streams, each variant's
+	// marked by a stream of its id spanning them
+	var indexLength uint64
+	for i, m := range s.messages {
+		length, err := w.writeMessage(m)
+		if err != nil {
+			return err
+		}
+		s.indexes[i].Length = proto.Uint64(length)
+		indexLength += length
+	}
+	indexes := s.indexes
+	for i, v := range w.variants {
+		vs := s.variants[i]
+		var length uint64
+		for j, m := range vs.messages {
+			n, err := w.writeEncryptedMessage(m, v.local, vs.indexes[j].GetColumn(), vs.indexes[j].GetKind(), stripeID)
+			if err != nil {
+				return err
+			}
+			vs.indexes[j].Length = proto.Uint64(n)
+			length += n
+		}
+		indexes = append(indexes, &Stream{Kind: Stream_ENCRYPTED_INDEX.Enum(), Column: proto.Uint32(v.id), Length: &length})
+		indexLength += length
+	}
+	var dataLength uint64
+	for _, data := range s.data {
+		if err := w.write(data); err != nil {
+			return err
+		}
+		dataLength += uint64(len(data))
+	}
+	streams := s.streams
+	for i, v := range w.variants {
+		vs := s.variants[i]
+		var length uint64
+		for j, data := range vs.data {
+			n, err := w.writeEncrypted(data, v.local, vs.streams[j].GetColumn(), vs.streams[j].GetKind(), stripeID)
+			if err != nil {
+				return err
+			}
+			length += n
+		}
+		streams = append(streams, &Stream{Kind: Stream_ENCRYPTED_DATA.Enum(), Column: proto.Uint32(v.id), Length: &length})
+		dataLength += length
+	}
+
+	footer := &StripeFooter{
+		Streams:        append(indexes, streams...),
+		Columns:        s.encodings,
+		WriterTimezone: proto.String(w.loc.String()),
+	}
+	for i, v := range w.variants {
+		vs := s.variants[i]
+		footer.Encryption = append(footer.Encryption, &StripeEncryptionVariant{
+			Streams:  append(vs.indexes, vs.streams...),
+			Encoding: vs.encodings[v.root:v.end],
+		})
+	}
+	footerLength, err := w.writeMessage(footer)
+	if err != nil {
+		return err
+	}
+	info.IndexLength = &indexLength
+	info.DataLength = &dataLength
+	info.FooterLength = &footerLength
+
+	w.stripes = append(w.stripes, info)
+	w.stripeStats = append(w.stripeStats, &StripeStatistics{ColStats: stats})
+	w.numRows += w.stripeRows
+	w.stripeRows = 0
+	if w.memory != nil {
+		// the stripe no longer counts against the budget
+		w.stripeSize, _ = w.memory.check(w, w.root.size())
+	}
+	return nil
+}
+
+func (w *Writer) writeTail() error {
+	contentLength := w.offset
+
+	stats := make([]*ColumnStatistics, len(w.fileStats))
+	for id, s := range w.fileStats {
+		stats[id] = s.statistics()
+	}
+
+	encryption, statisticsLength, err := w.writeEncryption()
+	if err != nil {
+		return err
+	}
+	metadataLength, err := w.writeMessage(&Metadata{StripeStats: w.stripeStats})
+	if err != nil {
+		return err
+	}
+
+	var rowIndexStride uint32
+	if w.opts.RowIndexStride > 0 {
+		rowIndexStride = uint32(w.opts.RowIndexStride)
+	}
+	footer := &Footer{
+		HeaderLength:   proto.Uint64(uint64(len(MAGIC))),
+		ContentLength:  &contentLength,
+		Stripes:        w.stripes,
+		Types:          w.types,
+		NumberOfRows:   &w.numRows,
+		Statistics:     stats,
+		RowIndexStride: &rowIndexStride,
+		Metadata:       w.metadata,
+		Encryption:     encryption,
+	}
+	if w.calendar != CalendarKind_UNKNOWN_CALENDAR {
+		footer.Calendar = w.calendar.Enum()
+	}
+	footerLength, err := w.writeMessage(footer)
+	if err != nil {
+		return err
+	}
+
+	compression := w.opts.Compression
+	postscript := &PostScript{
+		FooterLength:         &footerLength,
+		Compression:          &compression,
+		CompressionBlockSize: proto.Uint64(uint64(w.opts.CompressionBlockSize)),
+		Version:              w.fileVersion,
+		MetadataLength:       &metadataLength,
+		WriterVersion:        &w.writerVersion,
+		Magic:                proto.String(MAGIC),
+	}
+	if statisticsLength > 0 {
+		postscript.StripeStatisticsLength = &statisticsLength
+	}
+	ps, err := proto.Marshal(postscript)
+	if err != nil {
+		return err
+	}
+	if len(ps) > 255 {
+		return fmt.Errorf("postscript length %d exceeds 255", len(ps))
+	}
+	if err := w.write(ps); err != nil {
+		return err
+	}
+	return w.write([]byte{byte(len(ps))})
+}
+
+// writeMessage marshals, compresses and writes a protobuf message, returning the number of bytes written
+func (w *Writer) writeMessage(m proto.Message) (uint64, error) {
+	buf, err := proto.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	data := w.compress(buf)
+	if err := w.write(data); err != nil {
+		return 0, err
+	}
+	return uint64(len(data)), nil
+}
+
+// writeEncryptedMessage marshals, compresses, encrypts and writes a protobuf message, returning the number of bytes
+// written
+func (w *Writer) writeEncryptedMessage(m proto.Message, key []byte, column uint32, kind Stream_Kind,
+	stripeID uint64) (uint64, error) {
+	buf, err := proto.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	return w.writeEncrypted(w.compress(buf), key, column, kind, stripeID)
+}
+
+// writeEncrypted encrypts and writes the compressed data of a stream, returning the number of bytes written
+func (w *Writer) writeEncrypted(data, key []byte, column uint32, kind Stream_Kind, stripeID uint64) (uint64, error) {
+	data, err := crypt(key, encryptionIV(column, kind, stripeID), data)
+	if err != nil {
+		return 0, err
+	}
+	if err := w.write(data); err != nil {
+		return 0, err
+	}
+	return uint64(len(data)), nil
+}
+
+// compress splits data into chunks of at most the compression block size, each prefixed by a 3 byte header and
+// stored uncompressed when compression does not make it smaller
+func (w *Writer) compress(data []byte) []byte {
+	if w.opts.Compression == CompressionKind_NONE {
+		// callers reuse their buffers
+		return append([]byte(nil), data...)
+	}
+	var out bytes.Buffer
+	for len(data) > 0 {
+		n := w.opts.CompressionBlockSize
+		if n > len(data) {
+			n = len(data)
+		}
+		chunk := data[:n]
+		data = data[n:]
+
+		var compressed []byte
+		switch w.opts.Compression {
+		case CompressionKind_ZLIB:
+			var buf bytes.Buffer
+			zw, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+			zw.Write(chunk)
+			zw.Close()
+			compressed = buf.Bytes()
+		case CompressionKind_SNAPPY:
+			compressed = snappy.Encode(nil, chunk)
+		}
+
+		var header [4]byte
+		if len(compressed) < len(chunk) {
+			binary.LittleEndian.PutUint32(header[:], uint32(len(compressed))<<1)
+			out.Write(header[:3])
+			out.Write(compressed)
+		} else {
+			binary.LittleEndian.PutUint32(header[:], uint32(len(chunk))<<1|1)
+			out.Write(header[:3])
+			out.Write(chunk)
+		}
+	}
+	return out.Bytes()
+}
@@ -0,0 +1,555 @@
+package orc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// allTypes is a schema using every type kind
+func allTypes() []*Type {
+	kind := func(k Type_Kind) *Type_Kind { return k.Enum() }
+	return []*Type{
+		{Kind: kind(Type_STRUCT), Subtypes: []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17, 20, 22},
+			FieldNames: []string{"boolean", "byte", "short", "int", "long", "float", "double", "string", "varchar",
+				"char", "binary", "timestamp", "date", "decimal", "list", "map", "struct", "union"}},
+		{Kind: kind(Type_BOOLEAN)},
+		{Kind: kind(Type_BYTE)},
+		{Kind: kind(Type_SHORT)},
+		{Kind: kind(Type_INT)},
+		{Kind: kind(Type_LONG)},
+		{Kind: kind(Type_FLOAT)},
+		{Kind: kind(Type_DOUBLE)},
+		{Kind: kind(Type_STRING)},
+		{Kind: kind(Type_VARCHAR), MaximumLength: proto.Uint32(10)},
+		{Kind: kind(Type_CHAR), MaximumLength: proto.Uint32(3)},
+		{Kind: kind(Type_BINARY)},
+		{Kind: kind(Type_TIMESTAMP)},
+		{Kind: kind(Type_DATE)},
+		{Kind: kind(Type_DECIMAL), Precision: proto.Uint32(10), Scale: proto.Uint32(2)},
+		{Kind: kind(Type_LIST), Subtypes: []uint32{16}},
+		{Kind: kind(Type_LONG)},
+		{Kind: kind(Type_MAP), Subtypes: []uint32{18, 19}},
+		{Kind: kind(Type_STRING)},
+		{Kind: kind(Type_DOUBLE)},
+		{Kind: kind(Type_STRUCT), Subtypes: []uint32{21}, FieldNames: []string{"inner"}},
+		{Kind: kind(Type_INT)},
+		{Kind: kind(Type_UNION), Subtypes: []uint32{23, 24}},
+		{Kind: kind(Type_INT)},
+		{Kind: kind(Type_STRING)},
+	}
+}
+
+func allTypesRow(i int) []interface{} {
+	if i%7 == 3 {
+		return make([]interface{}, 18)
+	}
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	var union Union
+	if i%2 == 0 {
+		union = Union{Tag: 0, Value: int32(i)}
+	} else {
+		union = Union{Tag: 1, Value: "odd"}
+	}
+	return []interface{}{
+		i%3 == 0,
+		int8(i),
+		int16(i * 3),
+		int32(i * 1000),
+		int64(i) << 40,
+		float32(i) / 4,
+		float64(i) / 3,
+		[]string{"a", "b", "c"}[i%3],
+		"varchar",
+		"chr",
+		[]byte{byte(i), byte(i >> 8)},
+		time.Date(2017, time.March, 4, 5, 6, 7, i*1000, la).Add(time.Duration(i) * time.Hour),
+		time.Date(1969, time.December, 1+i%28, 0, 0, 0, 0, time.UTC),
+		Decimal{Value: big.NewInt(int64(i*101 - 5000)), Scale: 2},
+		[]interface{}{int64(i), nil, int64(-i)},
+		[]MapEntry{{Key: "key", Value: float64(i)}},
+		[]interface{}{int32(-i)},
+		union,
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	for _, compression := range []CompressionKind{CompressionKind_NONE, CompressionKind_ZLIB, CompressionKind_SNAPPY} {
+		t.Run(compression.String(), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "all.orc")
+			out, err := os.Create(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w, err := NewWriter(out, allTypes(), &WriterOptions{
+				Compression:          compression,
+				CompressionBlockSize: 1024,
+				StripeSize:           16 * 1024,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			const n = 5000
+			for i := 0; i < n; i++ {
+				if err := w.WriteRow(allTypesRow(i)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			out.Close()
+
+			o, err := Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer o.Close()
+			if o.Footer.GetNumberOfRows() != n {
+				t.Errorf("got %d rows; want %d", o.Footer.GetNumberOfRows(), n)
+			}
+			if len(o.Footer.GetStripes()) < 2 {
+				t.Errorf("got %d stripes; want several", len(o.Footer.GetStripes()))
+			}
+
+			rows := o.Rows(context.Background(), nil)
+			defer rows.Close()
+			i := 0
+			for ; rows.Next(); i++ {
+				want := allTypesRow(i)
+				got := rows.Row()
+				for c := range want {
+					if !sameValue(got[c], want[c]) {
+						t.Fatalf("row %d column %d: got %v; want %v", i, c, got[c], want[c])
+					}
+				}
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if i != n {
+				t.Errorf("read %d rows; want %d", i, n)
+			}
+		})
+	}
+}
+
+// sameValue compares values as written to values as read, which may be in another location or representation
+func sameValue(got, want interface{}) bool {
+	switch w := want.(type) {
+	case time.Time:
+		g, ok := got.(time.Time)
+		return ok && g.Equal(w)
+	case Decimal:
+		g, ok := got.(Decimal)
+		return ok && g.String() == w.String()
+	}
+	return reflect.DeepEqual(got, want)
+}
+
+func TestWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, allTypes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(allTypesRow(0)); err != errWriterClosed {
+		t.Errorf("got %v; want %v", err, errWriterClosed)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte(MAGIC)) {
+		t.Error("missing file header")
+	}
+}
+
+func TestWriterUserMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []*Type{{Kind: Type_LONG.Enum()}}, &WriterOptions{
+		UserMetadata: map[string][]byte{"lineage": []byte("job-1"), "fingerprint": {0, 1, 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddUserMetadata("lineage", []byte("job-2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddUserMetadata("empty", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddUserMetadata("late", nil); err != errWriterClosed {
+		t.Errorf("got %v; want %v", err, errWriterClosed)
+	}
+
+	f := openBytes(t, buf.Bytes())
+	var names []string
+	for _, item := range f.Footer.GetMetadata() {
+		names = append(names, item.GetName())
+	}
+	if want := []string{"fingerprint", "lineage", "empty"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got user metadata %v; want %v", names, want)
+	}
+	want := map[string][]byte{"lineage": []byte("job-2"), "fingerprint": {0, 1, 2}, "empty": nil}
+	for name, value := range want {
+		if got, ok := f.UserMetadataValue(name); !ok || !bytes.Equal(got, value) {
+			t.Errorf("%s: got %q, %t; want %q", name, got, ok, value)
+		}
+	}
+}
+
+func TestWriterTypeErrors(t *testing.T) {
+	types := allTypes()
+	types[0].Subtypes[1] = 3
+	if _, err := NewWriter(&bytes.Buffer{}, types, nil); err == nil {
+		t.Error("expected error for subtypes out of order")
+	}
+
+	w, err := NewWriter(&bytes.Buffer{}, allTypes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := allTypesRow(0)
+	row[1] = "not a byte"
+	if err := w.WriteRow(row); err == nil {
+		t.Error("expected error writing a string as a byte")
+	}
+}
+
+func TestIntRLEv2Writer(t *testing.T) {
+	sequences := [][]int64{
+		{1, 1, 1, 1, 1},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
+		{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		{10, 8, 6, 4, 2, 0, -2},
+		{-1, 1 << 62, -1 << 63, 1<<63 - 1, 0, 0, 0, 5},
+		{23713, 43806, 57005, 48879},
+		{1},
+	}
+	var long []int64
+	for i := int64(0); i < 2000; i++ {
+		long = append(long, i*i%1013-500)
+	}
+	sequences = append(sequences, long)
+
+	for _, signed := range []bool{true, false} {
+		for _, values := range sequences {
+			if !signed && values[0] < 0 {
+				continue
+			}
+			buf := &outStream{}
+			e := newIntRLEv2Writer(buf, signed)
+			for _, v := range values {
+				e.write(v)
+			}
+			e.flush()
+			d := newIntDecoder(bytes.NewReader(buf.finish()), ColumnEncoding_DIRECT_V2, signed)
+			for i, want := range values {
+				got, err := d.next()
+				if err != nil {
+					t.Fatalf("%v: value %d: %s", values, i, err)
+				}
+				if got != want {
+					t.Fatalf("value %d: got %d; want %d", i, got, want)
+				}
+			}
+		}
+	}
+}
+
+// rawStream returns a stream of a stripe as written, compressed
+func rawStream(t *testing.T, f *File, stripe int, column uint32, kind Stream_Kind) []byte {
+	info := f.Footer.GetStripes()[stripe]
+	footer, err := f.GetStripeFooter(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset := info.GetOffset()
+	for _, s := range footer.GetStreams() {
+		if s.GetColumn() == column && s.GetKind() == kind {
+			buf := make([]byte, s.GetLength())
+			if _, err := f.r.ReadAt(buf, int64(offset)); err != nil {
+				t.Fatal(err)
+			}
+			return buf
+		}
+		offset += s.GetLength()
+	}
+	t.Fatalf("no %s stream for column %d", kind, column)
+	return nil
+}
+
+func TestDictionaryEncoding(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2}, FieldNames: []string{"id", "enum"}},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_STRING.Enum()},
+	}
+	row := func(i int) []interface{} {
+		var enum interface{}
+		if i%4 != 0 {
+			enum = []string{"red", "green", "blue"}[i%3]
+		}
+		return []interface{}{fmt.Sprintf("id-%d", i), enum}
+	}
+	const n = 3000
+	for _, test := range []struct {
+		name string
+		opts WriterOptions
+		// want holds the encoding of each string column
+		want [2]ColumnEncoding_Kind
+	}{
+		{"default", WriterOptions{}, [2]ColumnEncoding_Kind{ColumnEncoding_DIRECT_V2, ColumnEncoding_DICTIONARY_V2}},
+		{"early check", WriterOptions{DictionaryCheckRows: 100},
+			[2]ColumnEncoding_Kind{ColumnEncoding_DIRECT_V2, ColumnEncoding_DICTIONARY_V2}},
+		{"no early check", WriterOptions{DictionaryCheckRows: -1},
+			[2]ColumnEncoding_Kind{ColumnEncoding_DIRECT_V2, ColumnEncoding_DICTIONARY_V2}},
+		{"always dictionary", WriterOptions{DictionaryKeySizeThreshold: 1},
+			[2]ColumnEncoding_Kind{ColumnEncoding_DICTIONARY_V2, ColumnEncoding_DICTIONARY_V2}},
+		{"never dictionary", WriterOptions{DictionaryKeySizeThreshold: -1},
+			[2]ColumnEncoding_Kind{ColumnEncoding_DIRECT_V2, ColumnEncoding_DIRECT_V2}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			opts := test.opts
+			opts.Compression = CompressionKind_SNAPPY
+			opts.StripeSize = 16 * 1024
+			w, err := NewWriter(&buf, types, &opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < n; i++ {
+				if err := w.WriteRow(row(i)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			f := openBytes(t, buf.Bytes())
+			if len(f.Footer.GetStripes()) < 2 {
+				t.Errorf("got %d stripes; want several", len(f.Footer.GetStripes()))
+			}
+			for i, info := range f.Footer.GetStripes() {
+				footer, err := f.GetStripeFooter(info)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for c, want := range test.want {
+					e := footer.GetColumns()[c+1]
+					if e.GetKind() != want {
+						t.Errorf("stripe %d column %d: got %s encoding; want %s", i, c+1, e.GetKind(), want)
+					}
+					if want == ColumnEncoding_DICTIONARY_V2 && c == 1 && e.GetDictionarySize() != 3 {
+						t.Errorf("stripe %d: got dictionary size %d; want 3", i, e.GetDictionarySize())
+					}
+				}
+			}
+
+			rows := f.Rows(context.Background(), nil)
+			defer rows.Close()
+			i := 0
+			for ; rows.Next(); i++ {
+				if got, want := rows.Row(), row(i); !reflect.DeepEqual(got, want) {
+					t.Fatalf("row %d: got %v; want %v", i, got, want)
+				}
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if i != n {
+				t.Errorf("read %d rows; want %d", i, n)
+			}
+		})
+	}
+}
+
+// TestRowIndex seeks to the start of each row group using the positions of the row index
+func TestRowIndex(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1, 2, 3, 4}, FieldNames: []string{"i", "s", "n", "d"}},
+		{Kind: Type_LONG.Enum()},
+		{Kind: Type_STRING.Enum()},
+		{Kind: Type_LONG.Enum()},
+		{Kind: Type_STRING.Enum()},
+	}
+	row := func(i int) []interface{} {
+		var n interface{}
+		if i%3 != 0 {
+			n = int64(i)
+		}
+		return []interface{}{int64(i * i), fmt.Sprintf("unique %d", i), n, fmt.Sprintf("key %d", i%7)}
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, types, &WriterOptions{
+		Compression:          CompressionKind_ZLIB,
+		CompressionBlockSize: 256,
+		RowIndexStride:       100,
+		// switching to direct encoding in the middle of a row group
+		DictionaryCheckRows: 150,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		if err := w.WriteRow(row(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f := openBytes(t, buf.Bytes())
+	if f.Footer.GetRowIndexStride() != 100 {
+		t.Errorf("got row index stride %d; want 100", f.Footer.GetRowIndexStride())
+	}
+
+	index := func(column uint32) []*RowIndexEntry {
+		var index RowIndex
+		if err := proto.Unmarshal(readIndexStreams(t, f, 0, column)[Stream_ROW_INDEX], &index); err != nil {
+			t.Fatal(err)
+		}
+		if len(index.GetEntry()) != 10 {
+			t.Fatalf("column %d: got %d row index entries; want 10", column, len(index.GetEntry()))
+		}
+		var values uint64
+		for _, e := range index.GetEntry() {
+			values += e.GetStatistics().GetNumberOfValues()
+		}
+		if want := f.Footer.GetStatistics()[column].GetNumberOfValues(); values != want {
+			t.Errorf("column %d: row groups hold %d values; want %d", column, values, want)
+		}
+		return index.GetEntry()
+	}
+	// seek returns a reader of a compressed stream from a compressed chunk and an offset within it
+	seek := func(stream []byte, chunk, offset uint64) *bufio.Reader {
+		r := bufio.NewReader(f.compressedReader(bytes.NewReader(stream[chunk:])))
+		if _, err := r.Discard(int(offset)); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	skip := func(d intDecoder, n uint64) {
+		for ; n > 0; n-- {
+			if _, err := d.next(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if positions := index(0)[1].GetPositions(); len(positions) != 0 {
+		t.Errorf("got positions %v for a struct without nulls", positions)
+	}
+
+	ints := rawStream(t, f, 0, 1, Stream_DATA)
+	for g, e := range index(1) {
+		p := e.GetPositions()
+		if len(p) != 3 {
+			t.Fatalf("row group %d: got positions %v; want 3", g, p)
+		}
+		d := newIntDecoder(seek(ints, p[0], p[1]), ColumnEncoding_DIRECT_V2, true)
+		skip(d, p[2])
+		if v, err := d.next(); err != nil || v != row(100 * g)[0] {
+			t.Errorf("row group %d: got %d, %v; want %d", g, v, err, row(100 * g)[0])
+		}
+	}
+
+	data, lengths := rawStream(t, f, 0, 2, Stream_DATA), rawStream(t, f, 0, 2, Stream_LENGTH)
+	for g, e := range index(2) {
+		p := e.GetPositions()
+		if len(p) != 5 {
+			t.Fatalf("row group %d: got positions %v; want 5", g, p)
+		}
+		d := newIntDecoder(seek(lengths, p[2], p[3]), ColumnEncoding_DIRECT_V2, false)
+		skip(d, p[4])
+		length, err := d.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		value := make([]byte, length)
+		if _, err := io.ReadFull(seek(data, p[0], p[1]), value); err != nil {
+			t.Fatal(err)
+		}
+		if string(value) != row(100 * g)[1] {
+			t.Errorf("row group %d: got %q; want %q", g, value, row(100 * g)[1])
+		}
+	}
+
+	for g, e := range index(3) {
+		// PRESENT positions followed by DATA positions
+		if p := e.GetPositions(); len(p) != 7 {
+			t.Errorf("row group %d: got positions %v; want 7", g, p)
+		}
+	}
+
+	keys := rawStream(t, f, 0, 4, Stream_DATA)
+	for g, e := range index(4) {
+		p := e.GetPositions()
+		if len(p) != 3 {
+			t.Fatalf("row group %d: got positions %v; want 3", g, p)
+		}
+		d := newIntDecoder(seek(keys, p[0], p[1]), ColumnEncoding_DICTIONARY_V2, false)
+		skip(d, p[2])
+		// keys sort in the order of their digit
+		if v, err := d.next(); err != nil || v != int64(100*g%7) {
+			t.Errorf("row group %d: got dictionary index %d, %v; want %d", g, v, err, 100*g%7)
+		}
+	}
+}
+
+func TestWriterTimestampEpoch(t *testing.T) {
+	types := []*Type{
+		{Kind: Type_STRUCT.Enum(), Subtypes: []uint32{1}, FieldNames: []string{"t"}},
+		{Kind: Type_TIMESTAMP.Enum()},
+	}
+	epoch := time.Unix(0, 0).UTC()
+	for _, test := range []struct {
+		written, read time.Time
+	}{
+		{epoch.Add(-1500 * time.Millisecond), epoch.Add(-1500 * time.Millisecond)},
+		{epoch.Add(-time.Second + 500), epoch.Add(-time.Second + 500)},
+		{epoch.Add(-time.Second + time.Millisecond - 1), epoch.Add(-time.Second + time.Millisecond - 1)},
+		// the format has no seconds for the instants less than a second before the epoch with a millisecond or more
+		// of nanos, which the Java library reads a second later as well
+		{time.Date(1969, time.December, 31, 23, 59, 59, 500000000, time.UTC), epoch.Add(500 * time.Millisecond)},
+		{epoch.Add(-time.Millisecond), epoch.Add(time.Second - time.Millisecond)},
+		{epoch.Add(500 * time.Millisecond), epoch.Add(500 * time.Millisecond)},
+	} {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, types, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.WriteRow([]interface{}{test.written}); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		f := openBytes(t, buf.Bytes())
+		if rows := readAll(t, f); len(rows) != 1 || !sameValue(rows[0][0], test.read) {
+			t.Errorf("%s: got %v; want %s", test.written.Format(time.RFC3339Nano), rows, test.read)
+		}
+		// the statistics hold the values as they read
+		mismatches, err := f.VerifyStatistics(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range mismatches {
+			t.Errorf("%s: statistics mismatch %v", test.written.Format(time.RFC3339Nano), m)
+		}
+	}
+}